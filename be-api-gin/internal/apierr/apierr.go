@@ -0,0 +1,138 @@
+// Package apierr is the catalog of typed error codes the gateway returns to
+// API clients. Handlers stop inventing their own {"error": ..., "message":
+// ...} bodies and status codes per call site; instead a gRPC or internal
+// failure is translated into one of these codes once (see
+// pkg/grpc.TranslateError) and rendered once (see handlers.RespondError).
+package apierr
+
+import "net/http"
+
+// Code identifies one entry in the registry below. Business codes (the ones
+// specific to this domain, e.g. CodeInventoryInsufficient) are distinct from
+// generic transport-level codes (e.g. CodeNotFound) so a handler or client
+// can react to "this SKU is out of stock" without string-matching a message.
+type Code string
+
+const (
+	CodeNotFound Code = "NOT_FOUND"
+	// CodeUnauthenticated is "who are you" (no/invalid credentials, 401).
+	// CodeUnauthorized is "I know who you are, but no" (403). Don't conflate
+	// them: a client that gets 403 back for a missing token will retry with
+	// the same missing token forever instead of re-authenticating.
+	CodeUnauthenticated    Code = "UNAUTHENTICATED"
+	CodeUnauthorized       Code = "UNAUTHORIZED"
+	CodeInvalidArgument    Code = "INVALID_ARGUMENT"
+	CodeFailedPrecondition Code = "FAILED_PRECONDITION"
+	CodeResourceExhausted  Code = "RESOURCE_EXHAUSTED"
+	CodeInternal           Code = "INTERNAL"
+
+	CodeProductNotFound       Code = "PRODUCT_NOT_FOUND"
+	CodeOrderNotFound         Code = "ORDER_NOT_FOUND"
+	CodeInventoryInsufficient Code = "INVENTORY_INSUFFICIENT"
+	CodeReservationExpired    Code = "RESERVATION_EXPIRED"
+	CodeOrderNotCancellable   Code = "ORDER_NOT_CANCELLABLE"
+)
+
+// Entry is the static, non-request-specific half of a Code: the HTTP status
+// it maps to, the message shown when a more specific one wasn't supplied,
+// and, for validation-style errors, which request field it concerns.
+type Entry struct {
+	Status  int
+	Message string
+	Field   string // optional; set for errors that point at one request field
+}
+
+// registry maps every known Code to its Entry. Unlisted codes fall back to
+// CodeInternal in Lookup, so a gRPC code this gateway doesn't recognize
+// still degrades to a safe 500 instead of an empty response.
+var registry = map[Code]Entry{
+	CodeNotFound:           {Status: http.StatusNotFound, Message: "The requested resource was not found"},
+	CodeUnauthenticated:    {Status: http.StatusUnauthorized, Message: "Authentication is required to perform this action"},
+	CodeUnauthorized:       {Status: http.StatusForbidden, Message: "You don't have permission to perform this action"},
+	CodeInvalidArgument:    {Status: http.StatusBadRequest, Message: "The request was invalid"},
+	CodeFailedPrecondition: {Status: http.StatusConflict, Message: "The request could not be completed in the resource's current state"},
+	CodeResourceExhausted:  {Status: http.StatusTooManyRequests, Message: "Too many requests, please try again later"},
+	CodeInternal:           {Status: http.StatusInternalServerError, Message: "Something went wrong, please try again"},
+
+	CodeProductNotFound:       {Status: http.StatusNotFound, Message: "No product exists with the given ID", Field: "product_id"},
+	CodeOrderNotFound:         {Status: http.StatusNotFound, Message: "No order exists with the given ID", Field: "order_id"},
+	CodeInventoryInsufficient: {Status: http.StatusConflict, Message: "Product does not have enough stock", Field: "product_id"},
+	CodeReservationExpired:    {Status: http.StatusConflict, Message: "The inventory reservation for this order has expired"},
+	CodeOrderNotCancellable:   {Status: http.StatusBadRequest, Message: "Order can only be cancelled when in pending or confirmed status"},
+}
+
+// Lookup returns the Entry for code, falling back to CodeInternal's Entry
+// (with ok=false) when code is not registered.
+func Lookup(code Code) (Entry, bool) {
+	entry, ok := registry[code]
+	if !ok {
+		return registry[CodeInternal], false
+	}
+	return entry, true
+}
+
+// Error pairs a Code with the underlying cause, and optionally a
+// caller-supplied message/field that should be shown instead of the
+// registry's defaults (e.g. naming the specific product that's out of
+// stock).
+type Error struct {
+	Code    Code
+	Cause   error
+	Message string // overrides the registry Entry's Message when non-empty
+	Field   string // overrides the registry Entry's Field when non-empty
+}
+
+// New builds an Error for code wrapping cause. cause may be nil.
+func New(code Code, cause error) *Error {
+	return &Error{Code: code, Cause: cause}
+}
+
+// WithMessage returns e with Message overridden, for handlers that know a
+// more specific, user-facing message than the registry default.
+func (e *Error) WithMessage(message string) *Error {
+	e.Message = message
+	return e
+}
+
+// WithField returns e with Field overridden.
+func (e *Error) WithField(field string) *Error {
+	e.Field = field
+	return e
+}
+
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return string(e.Code) + ": " + e.Cause.Error()
+	}
+	return string(e.Code) + ": " + e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// Status returns the HTTP status code this error should be rendered as.
+func (e *Error) Status() int {
+	entry, _ := Lookup(e.Code)
+	return entry.Status
+}
+
+// UserMessage returns the message to show the client: e.Message if set,
+// otherwise the registry default for e.Code.
+func (e *Error) UserMessage() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	entry, _ := Lookup(e.Code)
+	return entry.Message
+}
+
+// UserField returns the field this error concerns, if any: e.Field if set,
+// otherwise the registry default for e.Code.
+func (e *Error) UserField() string {
+	if e.Field != "" {
+		return e.Field
+	}
+	entry, _ := Lookup(e.Code)
+	return entry.Field
+}
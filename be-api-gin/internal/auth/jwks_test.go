@@ -0,0 +1,231 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func b64(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+func rsaJWK(t *testing.T, kid string, key *rsa.PublicKey) jwk {
+	t.Helper()
+	return jwk{
+		Kty: "RSA",
+		Kid: kid,
+		Alg: "RS256",
+		N:   b64(key.N.Bytes()),
+		E:   b64(big64(key.E)),
+	}
+}
+
+// big64 mirrors how a real JWKS document encodes a small exponent like
+// 65537: as the minimal big-endian byte string, not a fixed-width int.
+func big64(e int) []byte {
+	v := e
+	var b []byte
+	for v > 0 {
+		b = append([]byte{byte(v & 0xff)}, b...)
+		v >>= 8
+	}
+	if len(b) == 0 {
+		b = []byte{0}
+	}
+	return b
+}
+
+func ecJWK(t *testing.T, kid string, key *ecdsa.PublicKey) jwk {
+	t.Helper()
+	size := (key.Curve.Params().BitSize + 7) / 8
+	return jwk{
+		Kty: "EC",
+		Kid: kid,
+		Alg: "ES256",
+		Crv: "P-256",
+		X:   b64(key.X.FillBytes(make([]byte, size))),
+		Y:   b64(key.Y.FillBytes(make([]byte, size))),
+	}
+}
+
+// jwksServer serves a mutable JWKS document so a test can simulate key
+// rotation happening between the provider's initial load and a later Key
+// call, without waiting on the background refresh ticker.
+type jwksServer struct {
+	mu   sync.Mutex
+	doc  jwksDocument
+	hits int32
+}
+
+func newJWKSServer(doc jwksDocument) *jwksServer {
+	return &jwksServer{doc: doc}
+}
+
+func (s *jwksServer) set(doc jwksDocument) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.doc = doc
+}
+
+func (s *jwksServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt32(&s.hits, 1)
+	s.mu.Lock()
+	doc := s.doc
+	s.mu.Unlock()
+	_ = json.NewEncoder(w).Encode(doc)
+}
+
+func TestJWKSProviderKeyRSA(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+
+	srv := newJWKSServer(jwksDocument{Keys: []jwk{rsaJWK(t, "rsa-1", &rsaKey.PublicKey)}})
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	p, err := NewJWKSProvider(ts.URL, time.Hour)
+	if err != nil {
+		t.Fatalf("new provider: %v", err)
+	}
+	defer p.Close()
+
+	got, err := p.Key("rsa-1", jwt.SigningMethodRS256)
+	if err != nil {
+		t.Fatalf("Key: %v", err)
+	}
+	pub, ok := got.(*rsa.PublicKey)
+	if !ok {
+		t.Fatalf("got %T, want *rsa.PublicKey", got)
+	}
+	if pub.N.Cmp(rsaKey.PublicKey.N) != 0 || pub.E != rsaKey.PublicKey.E {
+		t.Fatal("decoded RSA public key does not match the original")
+	}
+}
+
+func TestJWKSProviderKeyECDSA(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ec key: %v", err)
+	}
+
+	srv := newJWKSServer(jwksDocument{Keys: []jwk{ecJWK(t, "ec-1", &ecKey.PublicKey)}})
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	p, err := NewJWKSProvider(ts.URL, time.Hour)
+	if err != nil {
+		t.Fatalf("new provider: %v", err)
+	}
+	defer p.Close()
+
+	got, err := p.Key("ec-1", jwt.SigningMethodES256)
+	if err != nil {
+		t.Fatalf("Key: %v", err)
+	}
+	pub, ok := got.(*ecdsa.PublicKey)
+	if !ok {
+		t.Fatalf("got %T, want *ecdsa.PublicKey", got)
+	}
+	if pub.X.Cmp(ecKey.PublicKey.X) != 0 || pub.Y.Cmp(ecKey.PublicKey.Y) != 0 {
+		t.Fatal("decoded EC public key does not match the original")
+	}
+}
+
+func TestJWKSProviderKeyRejectsUnsupportedMethod(t *testing.T) {
+	srv := newJWKSServer(jwksDocument{})
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	p, err := NewJWKSProvider(ts.URL, time.Hour)
+	if err != nil {
+		t.Fatalf("new provider: %v", err)
+	}
+	defer p.Close()
+
+	if _, err := p.Key("whatever", jwt.SigningMethodHS256); err == nil {
+		t.Fatal("expected an error for a non-RSA/ECDSA signing method")
+	}
+}
+
+func TestJWKSProviderKeyRejectsMissingKid(t *testing.T) {
+	srv := newJWKSServer(jwksDocument{})
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	p, err := NewJWKSProvider(ts.URL, time.Hour)
+	if err != nil {
+		t.Fatalf("new provider: %v", err)
+	}
+	defer p.Close()
+
+	if _, err := p.Key("", jwt.SigningMethodRS256); err == nil {
+		t.Fatal("expected an error for a token with no kid")
+	}
+}
+
+func TestJWKSProviderKeyForcesRefreshOnRotatedKid(t *testing.T) {
+	rsaKey1, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+	rsaKey2, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+
+	srv := newJWKSServer(jwksDocument{Keys: []jwk{rsaJWK(t, "rsa-1", &rsaKey1.PublicKey)}})
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	// Long refresh interval: only an explicit Key-triggered refresh, not the
+	// background ticker, should pick up the rotated key below.
+	p, err := NewJWKSProvider(ts.URL, time.Hour)
+	if err != nil {
+		t.Fatalf("new provider: %v", err)
+	}
+	defer p.Close()
+
+	// Simulate the issuer rotating in a new key after the provider's initial
+	// load.
+	srv.set(jwksDocument{Keys: []jwk{
+		rsaJWK(t, "rsa-1", &rsaKey1.PublicKey),
+		rsaJWK(t, "rsa-2", &rsaKey2.PublicKey),
+	}})
+
+	got, err := p.Key("rsa-2", jwt.SigningMethodRS256)
+	if err != nil {
+		t.Fatalf("Key should force a synchronous refresh and find the rotated key: %v", err)
+	}
+	pub, ok := got.(*rsa.PublicKey)
+	if !ok || pub.N.Cmp(rsaKey2.PublicKey.N) != 0 {
+		t.Fatal("Key returned the wrong public key for the rotated kid")
+	}
+}
+
+func TestJWKSProviderKeyUnknownKidAfterRefresh(t *testing.T) {
+	srv := newJWKSServer(jwksDocument{})
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	p, err := NewJWKSProvider(ts.URL, time.Hour)
+	if err != nil {
+		t.Fatalf("new provider: %v", err)
+	}
+	defer p.Close()
+
+	if _, err := p.Key("missing", jwt.SigningMethodRS256); err == nil {
+		t.Fatal("expected an error for a kid absent from the JWKS document even after refresh")
+	}
+}
@@ -0,0 +1,37 @@
+// Package auth resolves JWT verification keys for middleware.AuthMiddleware,
+// supporting both a static HMAC secret and rotating asymmetric keys served
+// from a JWKS endpoint.
+package auth
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// KeyProvider resolves the verification key for a token, given the key ID
+// from its header (may be empty) and the signing method it declares.
+// Implementations decide whether a given method/kid combination is
+// acceptable at all.
+type KeyProvider interface {
+	Key(kid string, method jwt.SigningMethod) (interface{}, error)
+}
+
+// StaticHMACProvider verifies HS256 tokens against a single fixed secret,
+// matching the gateway's original hardcoded-secret behavior.
+type StaticHMACProvider struct {
+	secret []byte
+}
+
+// NewStaticHMACProvider builds a StaticHMACProvider for secret.
+func NewStaticHMACProvider(secret string) *StaticHMACProvider {
+	return &StaticHMACProvider{secret: []byte(secret)}
+}
+
+// Key implements KeyProvider.
+func (p *StaticHMACProvider) Key(_ string, method jwt.SigningMethod) (interface{}, error) {
+	if _, ok := method.(*jwt.SigningMethodHMAC); !ok {
+		return nil, fmt.Errorf("auth: unsupported signing method %q for static HMAC provider", method.Alg())
+	}
+	return p.secret, nil
+}
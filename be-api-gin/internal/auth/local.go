@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// LocalRSAProvider verifies RS256 tokens against this service's own RSA
+// keypair and can publish the matching public key as a JWKS document via
+// JWKSDocument, so other services can verify tokens this gateway issues
+// without sharing the private key out of band.
+type LocalRSAProvider struct {
+	privateKey *rsa.PrivateKey
+	kid        string
+}
+
+// NewLocalRSAProviderFromPEMFile loads an RSA private key (PKCS#1 or PKCS#8,
+// PEM-encoded) from path and derives a stable kid from its public key so
+// rotating the key file also rotates the published kid.
+func NewLocalRSAProviderFromPEMFile(path string) (*LocalRSAProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("auth: failed to read RSA private key at %s: %w", path, err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("auth: no PEM block found in %s", path)
+	}
+
+	key, err := parseRSAPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalRSAProvider{
+		privateKey: key,
+		kid:        kidForKey(&key.PublicKey),
+	}, nil
+}
+
+func parseRSAPrivateKey(der []byte) (*rsa.PrivateKey, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	keyAny, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("auth: unable to parse RSA private key: %w", err)
+	}
+	key, ok := keyAny.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("auth: PKCS8 key is not an RSA key")
+	}
+	return key, nil
+}
+
+// kidForKey derives a stable, non-secret key ID from a public key's modulus
+// so that rotating to a new keypair also rotates the published kid.
+func kidForKey(pub *rsa.PublicKey) string {
+	sum := sha256.Sum256(pub.N.Bytes())
+	return base64.RawURLEncoding.EncodeToString(sum[:8])
+}
+
+// Key implements KeyProvider.
+func (p *LocalRSAProvider) Key(kid string, method jwt.SigningMethod) (interface{}, error) {
+	if _, ok := method.(*jwt.SigningMethodRSA); !ok {
+		return nil, fmt.Errorf("auth: unsupported signing method %q for local RSA provider", method.Alg())
+	}
+	if kid != "" && kid != p.kid {
+		return nil, fmt.Errorf("auth: unknown kid %q", kid)
+	}
+	return &p.privateKey.PublicKey, nil
+}
+
+// JWKSDocument renders this provider's public key as a JWKS document body,
+// suitable for serving from GET /.well-known/jwks.json.
+func (p *LocalRSAProvider) JWKSDocument() jwksDocument {
+	pub := p.privateKey.PublicKey
+	return jwksDocument{
+		Keys: []jwk{
+			{
+				Kty: "RSA",
+				Kid: p.kid,
+				Alg: "RS256",
+				N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			},
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ecommerce/be-api-gin/internal/config"
+)
+
+// NewProviderFromConfig builds the KeyProvider selected by cfg.JWTKeyMode
+// ("hmac" by default). Unknown modes fall back to static HMAC so a
+// misconfigured deployment still starts up able to verify the tokens it
+// always could.
+func NewProviderFromConfig(cfg *config.Config) (KeyProvider, error) {
+	switch cfg.JWTKeyMode {
+	case "jwks":
+		return NewJWKSProvider(cfg.JWKSURL, cfg.JWKSRefreshInterval)
+	case "rsa-local":
+		return NewLocalRSAProviderFromPEMFile(cfg.RSAPrivateKeyPath)
+	case "hmac", "":
+		return NewStaticHMACProvider(cfg.JWTSecret), nil
+	default:
+		return NewStaticHMACProvider(cfg.JWTSecret), nil
+	}
+}
+
+// JWKSHandler serves provider's public keys at GET /.well-known/jwks.json.
+// It only has a meaningful body when provider publishes keys (currently
+// LocalRSAProvider); other providers return an empty key set.
+func JWKSHandler(provider KeyProvider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		publisher, ok := provider.(interface{ JWKSDocument() jwksDocument })
+		w.Header().Set("Content-Type", "application/json")
+		if !ok {
+			json.NewEncoder(w).Encode(jwksDocument{Keys: []jwk{}})
+			return
+		}
+		json.NewEncoder(w).Encode(publisher.JWKSDocument())
+	}
+}
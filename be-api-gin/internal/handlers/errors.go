@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ecommerce/be-api-gin/internal/apierr"
+)
+
+// errorBody is the uniform shape every handler error renders as, instead of
+// each call site inventing its own {"error": ..., "message": ...} JSON.
+type errorBody struct {
+	Code      string      `json:"code"`
+	Message   string      `json:"message"`
+	RequestID string      `json:"request_id,omitempty"`
+	Details   interface{} `json:"details,omitempty"`
+}
+
+// RespondError writes err to c as a uniform JSON error body and sets the
+// HTTP status from the registry in internal/apierr. err is unwrapped with
+// errors.As, so a *apierr.Error keeps its own code even when a caller has
+// wrapped it (e.g. with fmt.Errorf's %w). Any error that doesn't unwrap to one
+// (e.g. a bug surfaced as a plain error) is rendered as a generic
+// apierr.CodeInternal rather than leaking its message.
+func RespondError(c *gin.Context, err error) {
+	var apiErr *apierr.Error
+	if !errors.As(err, &apiErr) {
+		apiErr = apierr.New(apierr.CodeInternal, err)
+	}
+
+	body := errorBody{
+		Code:    string(apiErr.Code),
+		Message: apiErr.UserMessage(),
+	}
+	if requestID, ok := c.Get("requestID"); ok {
+		body.RequestID, _ = requestID.(string)
+	}
+	if field := apiErr.UserField(); field != "" {
+		body.Details = gin.H{"field": field}
+	}
+
+	c.JSON(apiErr.Status(), body)
+}
+
+// specialize re-codes err into code when err is a generic apierr.Error
+// whose Code is apierr.CodeNotFound, letting a handler report a precise
+// business code (e.g. apierr.CodeProductNotFound) for a gRPC status that
+// only carried the generic one.
+func specialize(err error, code apierr.Code) error {
+	if apiErr, ok := err.(*apierr.Error); ok && apiErr.Code == apierr.CodeNotFound {
+		apiErr.Code = code
+	}
+	return err
+}
@@ -1,43 +1,77 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 
+	"github.com/ecommerce/be-api-gin/internal/apierr"
+	"github.com/ecommerce/be-api-gin/internal/config"
 	"github.com/ecommerce/be-api-gin/internal/models"
 	grpcclient "github.com/ecommerce/be-api-gin/pkg/grpc"
 )
 
+// productSortColumns are the ListProducts sort_column values the listing
+// service understands; an unrecognized one is a 400 rather than being
+// silently ignored.
+var productSortColumns = map[string]bool{
+	"name":       true,
+	"price":      true,
+	"created_at": true,
+	"popularity": true,
+}
+
 // ProductHandler handles product-related requests
 type ProductHandler struct {
+	Base
 	grpcClients *grpcclient.Clients
+	maxPageSize int
 }
 
-// NewProductHandler creates a new product handler
-func NewProductHandler(clients *grpcclient.Clients) *ProductHandler {
+// NewProductHandler creates a new product handler. cfg.MaxProductPageSize
+// caps how many products a single ListProducts call can request via
+// limit/count, regardless of what the caller asks for.
+func NewProductHandler(clients *grpcclient.Clients, cfg *config.Config) *ProductHandler {
 	return &ProductHandler{
+		Base:        NewBase(cfg),
 		grpcClients: clients,
+		maxPageSize: cfg.MaxProductPageSize,
 	}
 }
 
-// ListProducts returns a list of all products
+// ListProducts returns a list of products, filtered, sorted, and paginated
+// per the query parameters bound into a models.ProductSearch.
 // GET /api/v1/products
 func (h *ProductHandler) ListProducts(c *gin.Context) {
-	// Parse query parameters
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	category := c.Query("category")
-	search := c.Query("search")
+	ctx, errResp := h.StartPublicRequest(c, nil)
+	if errResp != nil {
+		RespondError(c, errResp)
+		return
+	}
+	defer ctx.Cancel()
+
+	var f models.ProductSearch
+	if err := c.ShouldBindWith(&f, binding.Form); err != nil {
+		RespondError(c, apierr.New(apierr.CodeInvalidArgument, err))
+		return
+	}
+
+	if errResp := validateProductSort(f); errResp != nil {
+		RespondError(c, errResp)
+		return
+	}
+
+	limit := resolveProductLimit(f, h.maxPageSize)
+	offset := resolveProductOffset(f, limit)
 
 	// Call listing service via gRPC
-	products, total, err := h.grpcClients.ListProducts(c.Request.Context(), page, limit, category, search)
+	products, total, err := h.grpcClients.ListProducts(ctx, &f, limit, offset)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error:   "Failed to fetch products",
-			Message: err.Error(),
-		})
+		RespondError(c, err)
 		return
 	}
 
@@ -50,38 +84,122 @@ func (h *ProductHandler) ListProducts(c *gin.Context) {
 		}
 	}
 
+	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
+	c.Header("X-Limit", strconv.Itoa(limit))
+	c.Header("X-Offset", strconv.Itoa(offset))
+	setProductsLinkHeader(c, total, limit, offset)
+
 	c.JSON(http.StatusOK, models.ProductsResponse{
 		Products: products,
-		Page:     page,
+		Page:     f.Page,
 		Limit:    limit,
 		Total:    total,
 	})
 }
 
+// validateProductSort checks f's sort_column against the listing service's
+// allowlist and sort_order against asc/desc, returning a 400 naming the
+// offending field rather than letting an unrecognized value reach the
+// listing service silently unsorted.
+func validateProductSort(f models.ProductSearch) *apierr.Error {
+	if f.SortColumn != "" && !productSortColumns[f.SortColumn] {
+		return apierr.New(apierr.CodeInvalidArgument, nil).
+			WithMessage(fmt.Sprintf("unknown sort_column %q", f.SortColumn)).
+			WithField("sort_column")
+	}
+	if f.SortOrder != "" && f.SortOrder != "asc" && f.SortOrder != "desc" {
+		return apierr.New(apierr.CodeInvalidArgument, nil).
+			WithMessage(fmt.Sprintf("unknown sort_order %q", f.SortOrder)).
+			WithField("sort_order")
+	}
+	return nil
+}
+
+// resolveProductLimit picks the effective page size: Count, when given,
+// supersedes Limit; the result is always clamped to (0, maxPageSize] with a
+// default of 10, regardless of what the caller asked for.
+func resolveProductLimit(f models.ProductSearch, maxPageSize int) int {
+	limit := f.Limit
+	if f.Count > 0 {
+		limit = f.Count
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	return limit
+}
+
+// resolveProductOffset picks the effective offset: Offset, when given,
+// supersedes deriving one from Page, since Offset is the cursor-style field
+// the gateway resolves everything down to before calling the listing
+// service.
+func resolveProductOffset(f models.ProductSearch, limit int) int {
+	offset := f.Offset
+	if offset <= 0 && f.Page > 1 {
+		offset = (f.Page - 1) * limit
+	}
+	return offset
+}
+
+// setProductsLinkHeader emits an RFC 5988 Link header with next/prev/first/
+// last relations computed from limit/offset, so pagination-aware clients can
+// page through ListProducts without parsing the response body.
+func setProductsLinkHeader(c *gin.Context, total int64, limit, offset int) {
+	if limit <= 0 {
+		return
+	}
+
+	u := *c.Request.URL
+	q := u.Query()
+	linkFor := func(off int) string {
+		q.Set("offset", strconv.Itoa(off))
+		q.Set("limit", strconv.Itoa(limit))
+		u.RawQuery = q.Encode()
+		return u.String()
+	}
+
+	rels := []string{fmt.Sprintf(`<%s>; rel="first"`, linkFor(0))}
+	if offset > 0 {
+		prev := offset - limit
+		if prev < 0 {
+			prev = 0
+		}
+		rels = append(rels, fmt.Sprintf(`<%s>; rel="prev"`, linkFor(prev)))
+	}
+	if int64(offset+limit) < total {
+		rels = append(rels, fmt.Sprintf(`<%s>; rel="next"`, linkFor(offset+limit)))
+	}
+	if total > 0 {
+		lastOffset := (int(total-1) / limit) * limit
+		rels = append(rels, fmt.Sprintf(`<%s>; rel="last"`, linkFor(lastOffset)))
+	}
+	c.Header("Link", strings.Join(rels, ", "))
+}
+
 // GetProduct returns a single product by ID
 // GET /api/v1/products/:id
 func (h *ProductHandler) GetProduct(c *gin.Context) {
+	ctx, errResp := h.StartPublicRequest(c, nil)
+	if errResp != nil {
+		RespondError(c, errResp)
+		return
+	}
+	defer ctx.Cancel()
+
 	id := c.Param("id")
 
 	// Call listing service via gRPC
-	product, err := h.grpcClients.GetProduct(c.Request.Context(), id)
+	product, err := h.grpcClients.GetProduct(ctx, id)
 	if err != nil {
-		if err == grpcclient.ErrNotFound {
-			c.JSON(http.StatusNotFound, models.ErrorResponse{
-				Error:   "Product not found",
-				Message: "No product exists with the given ID",
-			})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error:   "Failed to fetch product",
-			Message: err.Error(),
-		})
+		RespondError(c, specialize(err, apierr.CodeProductNotFound))
 		return
 	}
 
 	// Get inventory info
-	inventory, err := h.grpcClients.GetInventory(c.Request.Context(), id)
+	inventory, err := h.grpcClients.GetInventory(ctx, id)
 	if err == nil {
 		product.Stock = inventory.Quantity
 		product.Available = inventory.Available
@@ -101,29 +219,22 @@ func (h *ProductHandler) GetProduct(c *gin.Context) {
 // POST /api/v1/products
 func (h *ProductHandler) CreateProduct(c *gin.Context) {
 	var req models.CreateProductRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error:   "Invalid request body",
-			Message: err.Error(),
-		})
+	ctx, user, errResp := h.StartWriteRequest(c, &req)
+	if errResp != nil {
+		RespondError(c, errResp)
 		return
 	}
-
-	// Get user ID from context (set by auth middleware)
-	userID, _ := c.Get("userID")
+	defer ctx.Cancel()
 
 	// Call listing service via gRPC
-	product, err := h.grpcClients.CreateProduct(c.Request.Context(), &req, userID.(string))
+	product, err := h.grpcClients.CreateProduct(ctx, &req, user.ID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error:   "Failed to create product",
-			Message: err.Error(),
-		})
+		RespondError(c, err)
 		return
 	}
 
 	// Initialize inventory for the product
-	if err := h.grpcClients.InitializeInventory(c.Request.Context(), product.ID, req.InitialStock); err != nil {
+	if err := h.grpcClients.InitializeInventory(ctx, product.ID, req.InitialStock); err != nil {
 		// Log error but don't fail the request
 		// Inventory can be updated later
 	}
@@ -137,38 +248,17 @@ func (h *ProductHandler) UpdateProduct(c *gin.Context) {
 	id := c.Param("id")
 
 	var req models.UpdateProductRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error:   "Invalid request body",
-			Message: err.Error(),
-		})
+	ctx, user, errResp := h.StartWriteRequest(c, &req)
+	if errResp != nil {
+		RespondError(c, errResp)
 		return
 	}
-
-	// Get user ID from context
-	userID, _ := c.Get("userID")
+	defer ctx.Cancel()
 
 	// Call listing service via gRPC
-	product, err := h.grpcClients.UpdateProduct(c.Request.Context(), id, &req, userID.(string))
+	product, err := h.grpcClients.UpdateProduct(ctx, id, &req, user.ID)
 	if err != nil {
-		if err == grpcclient.ErrNotFound {
-			c.JSON(http.StatusNotFound, models.ErrorResponse{
-				Error:   "Product not found",
-				Message: "No product exists with the given ID",
-			})
-			return
-		}
-		if err == grpcclient.ErrUnauthorized {
-			c.JSON(http.StatusForbidden, models.ErrorResponse{
-				Error:   "Unauthorized",
-				Message: "You don't have permission to update this product",
-			})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error:   "Failed to update product",
-			Message: err.Error(),
-		})
+		RespondError(c, specialize(err, apierr.CodeProductNotFound))
 		return
 	}
 
@@ -180,30 +270,17 @@ func (h *ProductHandler) UpdateProduct(c *gin.Context) {
 func (h *ProductHandler) DeleteProduct(c *gin.Context) {
 	id := c.Param("id")
 
-	// Get user ID from context
-	userID, _ := c.Get("userID")
+	ctx, user, errResp := h.StartWriteRequest(c, nil)
+	if errResp != nil {
+		RespondError(c, errResp)
+		return
+	}
+	defer ctx.Cancel()
 
 	// Call listing service via gRPC
-	err := h.grpcClients.DeleteProduct(c.Request.Context(), id, userID.(string))
+	err := h.grpcClients.DeleteProduct(ctx, id, user.ID)
 	if err != nil {
-		if err == grpcclient.ErrNotFound {
-			c.JSON(http.StatusNotFound, models.ErrorResponse{
-				Error:   "Product not found",
-				Message: "No product exists with the given ID",
-			})
-			return
-		}
-		if err == grpcclient.ErrUnauthorized {
-			c.JSON(http.StatusForbidden, models.ErrorResponse{
-				Error:   "Unauthorized",
-				Message: "You don't have permission to delete this product",
-			})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error:   "Failed to delete product",
-			Message: err.Error(),
-		})
+		RespondError(c, specialize(err, apierr.CodeProductNotFound))
 		return
 	}
 
@@ -218,21 +295,17 @@ func (h *ProductHandler) UpdateInventory(c *gin.Context) {
 	id := c.Param("id")
 
 	var req models.UpdateInventoryRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error:   "Invalid request body",
-			Message: err.Error(),
-		})
+	ctx, _, errResp := h.StartWriteRequest(c, &req)
+	if errResp != nil {
+		RespondError(c, errResp)
 		return
 	}
+	defer ctx.Cancel()
 
 	// Call inventory service via gRPC
-	inventory, err := h.grpcClients.UpdateInventory(c.Request.Context(), id, req.Quantity, req.Operation)
+	inventory, err := h.grpcClients.UpdateInventory(ctx, id, req.Quantity, req.Operation)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error:   "Failed to update inventory",
-			Message: err.Error(),
-		})
+		RespondError(c, specialize(err, apierr.CodeProductNotFound))
 		return
 	}
 
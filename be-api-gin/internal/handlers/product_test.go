@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/ecommerce/be-api-gin/internal/apierr"
+	"github.com/ecommerce/be-api-gin/internal/models"
+)
+
+func TestValidateProductSortRejectsUnknownColumn(t *testing.T) {
+	err := validateProductSort(models.ProductSearch{SortColumn: "nonsense"})
+	if err == nil {
+		t.Fatal("expected an error for an unrecognized sort_column")
+	}
+	if err.Field != "sort_column" {
+		t.Fatalf("field = %q, want sort_column", err.Field)
+	}
+}
+
+func TestValidateProductSortRejectsUnknownOrder(t *testing.T) {
+	err := validateProductSort(models.ProductSearch{SortColumn: "price", SortOrder: "sideways"})
+	if err == nil {
+		t.Fatal("expected an error for an unrecognized sort_order")
+	}
+	if err.Field != "sort_order" {
+		t.Fatalf("field = %q, want sort_order", err.Field)
+	}
+}
+
+func TestValidateProductSortAllowsKnownValues(t *testing.T) {
+	for _, col := range []string{"name", "price", "created_at", "popularity", ""} {
+		for _, order := range []string{"asc", "desc", ""} {
+			if err := validateProductSort(models.ProductSearch{SortColumn: col, SortOrder: order}); err != nil {
+				t.Fatalf("sort_column=%q sort_order=%q: unexpected error %v", col, order, err)
+			}
+		}
+	}
+}
+
+func TestValidateProductSortErrorCode(t *testing.T) {
+	err := validateProductSort(models.ProductSearch{SortColumn: "nonsense"})
+	if err.Code != apierr.CodeInvalidArgument {
+		t.Fatalf("code = %s, want %s", err.Code, apierr.CodeInvalidArgument)
+	}
+}
+
+func TestResolveProductLimitDefaultsTo10(t *testing.T) {
+	if got := resolveProductLimit(models.ProductSearch{}, 100); got != 10 {
+		t.Fatalf("limit = %d, want 10", got)
+	}
+}
+
+func TestResolveProductLimitUsesLimitField(t *testing.T) {
+	if got := resolveProductLimit(models.ProductSearch{Limit: 25}, 100); got != 25 {
+		t.Fatalf("limit = %d, want 25", got)
+	}
+}
+
+func TestResolveProductLimitCountSupersedesLimit(t *testing.T) {
+	if got := resolveProductLimit(models.ProductSearch{Limit: 25, Count: 40}, 100); got != 40 {
+		t.Fatalf("limit = %d, want 40 (count should win)", got)
+	}
+}
+
+func TestResolveProductLimitCapsAtMaxPageSize(t *testing.T) {
+	if got := resolveProductLimit(models.ProductSearch{Count: 1000}, 50); got != 50 {
+		t.Fatalf("limit = %d, want 50 (capped)", got)
+	}
+}
+
+func TestResolveProductLimitIgnoresNonPositiveCount(t *testing.T) {
+	if got := resolveProductLimit(models.ProductSearch{Limit: 15, Count: -5}, 100); got != 15 {
+		t.Fatalf("limit = %d, want 15 (a non-positive count should not override limit)", got)
+	}
+}
+
+func TestResolveProductOffsetUsesOffsetField(t *testing.T) {
+	if got := resolveProductOffset(models.ProductSearch{Offset: 30, Page: 5}, 10); got != 30 {
+		t.Fatalf("offset = %d, want 30 (offset should win over page)", got)
+	}
+}
+
+func TestResolveProductOffsetDerivesFromPage(t *testing.T) {
+	if got := resolveProductOffset(models.ProductSearch{Page: 3}, 10); got != 20 {
+		t.Fatalf("offset = %d, want 20 ((page-1)*limit)", got)
+	}
+}
+
+func TestResolveProductOffsetDefaultsToZero(t *testing.T) {
+	if got := resolveProductOffset(models.ProductSearch{}, 10); got != 0 {
+		t.Fatalf("offset = %d, want 0", got)
+	}
+	if got := resolveProductOffset(models.ProductSearch{Page: 1}, 10); got != 0 {
+		t.Fatalf("offset = %d, want 0 for page 1", got)
+	}
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ecommerce/be-api-gin/internal/apierr"
+	"github.com/ecommerce/be-api-gin/internal/config"
+)
+
+// AuthedUser is the authenticated caller behind a request, resolved from the
+// gin context keys middleware.AuthMiddleware sets. It replaces the repeated
+// c.Get("userID") / .(string) pattern, which panics instead of failing the
+// request if AuthMiddleware was ever skipped for a route.
+type AuthedUser struct {
+	ID    string
+	Email string
+	Role  string
+}
+
+// RequestContext is the context.Context a handler uses for every gRPC call
+// made while serving one request. Cancel must be called once the handler is
+// done (typically via defer right after StartRequest), releasing the
+// deadline's timer and signalling in-flight gRPC calls to stop.
+type RequestContext struct {
+	context.Context
+	Cancel context.CancelFunc
+}
+
+// Base is embedded by every handler that calls StartRequest, giving it the
+// gateway's configured per-route deadlines.
+type Base struct {
+	readTimeout  time.Duration
+	writeTimeout time.Duration
+}
+
+// NewBase builds a Base from cfg's per-route deadlines.
+func NewBase(cfg *config.Config) Base {
+	return Base{
+		readTimeout:  cfg.HandlerReadTimeout,
+		writeTimeout: cfg.HandlerWriteTimeout,
+	}
+}
+
+// StartRequest is the read-deadlined entry point a handler opens with:
+//
+//	ctx, user, errResp := h.StartRequest(c, &req)
+//	if errResp != nil {
+//	    RespondError(c, errResp)
+//	    return
+//	}
+//	defer ctx.Cancel()
+//
+// body is bound and validated with c.ShouldBindJSON when non-nil; pass nil
+// for GET handlers that only read query/path parameters. Use
+// StartWriteRequest instead for handlers that place or mutate an order or
+// product, which get the longer write deadline.
+func (b Base) StartRequest(c *gin.Context, body interface{}) (*RequestContext, *AuthedUser, *apierr.Error) {
+	return b.startRequest(c, body, b.readTimeout, true)
+}
+
+// StartWriteRequest is StartRequest with the gateway's write deadline
+// (config.Config.HandlerWriteTimeout) instead of its read deadline.
+func (b Base) StartWriteRequest(c *gin.Context, body interface{}) (*RequestContext, *AuthedUser, *apierr.Error) {
+	return b.startRequest(c, body, b.writeTimeout, true)
+}
+
+// StartPublicRequest is StartRequest for routes gin never put behind
+// AuthMiddleware (e.g. ListProducts, GetProduct): same deadline and body
+// binding, but no AuthedUser to resolve.
+func (b Base) StartPublicRequest(c *gin.Context, body interface{}) (*RequestContext, *apierr.Error) {
+	rc, _, apiErr := b.startRequest(c, body, b.readTimeout, false)
+	return rc, apiErr
+}
+
+func (b Base) startRequest(c *gin.Context, body interface{}, timeout time.Duration, requireAuth bool) (*RequestContext, *AuthedUser, *apierr.Error) {
+	var user *AuthedUser
+	if requireAuth {
+		var apiErr *apierr.Error
+		user, apiErr = authedUserFromContext(c)
+		if apiErr != nil {
+			return nil, nil, apiErr
+		}
+	}
+
+	if body != nil {
+		if err := c.ShouldBindJSON(body); err != nil {
+			return nil, nil, apierr.New(apierr.CodeInvalidArgument, err)
+		}
+	}
+
+	requestID, _ := c.Get("requestID")
+	log.Printf("request_id=%v method=%s path=%s", requestID, c.Request.Method, c.Request.URL.Path)
+
+	// c.Request.Context() already carries the request ID that
+	// middleware.RequestIDMiddleware attached (see
+	// grpcclient.ContextWithRequestID), so deriving the deadline from it is
+	// enough for the outbound gRPC interceptor to keep propagating it.
+	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+	return &RequestContext{Context: ctx, Cancel: cancel}, user, nil
+}
+
+// authedUserFromContext reads the scalar fields middleware.AuthMiddleware
+// sets on the gin context into an AuthedUser, or a 401 if the route wasn't
+// actually behind that middleware.
+func authedUserFromContext(c *gin.Context) (*AuthedUser, *apierr.Error) {
+	idVal, exists := c.Get("userID")
+	if !exists {
+		return nil, apierr.New(apierr.CodeUnauthenticated, nil).WithMessage("Authentication required")
+	}
+	id, _ := idVal.(string)
+
+	email, _ := c.Get("email")
+	role, _ := c.Get("role")
+	emailStr, _ := email.(string)
+	roleStr, _ := role.(string)
+
+	return &AuthedUser{ID: id, Email: emailStr, Role: roleStr}, nil
+}
@@ -0,0 +1,72 @@
+package idempotency
+
+import (
+	"sync"
+	"time"
+)
+
+type entry struct {
+	record    Record
+	expiresAt time.Time
+}
+
+// MemoryStore is an in-process Store, used in tests and when
+// IdempotencyStoreDriver is "memory". State does not survive a restart and
+// is not shared across gateway replicas.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+// NewMemoryStore builds an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]entry)}
+}
+
+func memKey(userID, key string) string {
+	return userID + "\x00" + key
+}
+
+// Reserve implements Store.
+func (s *MemoryStore) Reserve(userID, key, fingerprint string, leaseTTL time.Duration) (Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mk := memKey(userID, key)
+	if e, ok := s.entries[mk]; ok && time.Now().Before(e.expiresAt) {
+		if !e.record.Done {
+			return Record{}, false, ErrInFlight
+		}
+		if e.record.Fingerprint != fingerprint {
+			return Record{}, false, ErrFingerprintMismatch
+		}
+		return e.record, false, nil
+	}
+
+	s.entries[mk] = entry{
+		record:    Record{Fingerprint: fingerprint},
+		expiresAt: time.Now().Add(leaseTTL),
+	}
+	return Record{}, true, nil
+}
+
+// Complete implements Store.
+func (s *MemoryStore) Complete(userID, key string, record Record, window time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record.Done = true
+	s.entries[memKey(userID, key)] = entry{
+		record:    record,
+		expiresAt: time.Now().Add(window),
+	}
+	return nil
+}
+
+// Release implements Store.
+func (s *MemoryStore) Release(userID, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, memKey(userID, key))
+	return nil
+}
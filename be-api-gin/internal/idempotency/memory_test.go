@@ -0,0 +1,96 @@
+package idempotency
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreReserveClaimsUnseenKey(t *testing.T) {
+	s := NewMemoryStore()
+
+	_, claimed, err := s.Reserve("user-1", "key-1", "fp-1", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !claimed {
+		t.Fatal("expected the first Reserve for a key to claim it")
+	}
+}
+
+func TestMemoryStoreReserveInFlight(t *testing.T) {
+	s := NewMemoryStore()
+
+	if _, claimed, err := s.Reserve("user-1", "key-1", "fp-1", time.Minute); err != nil || !claimed {
+		t.Fatalf("first reserve: claimed=%v err=%v", claimed, err)
+	}
+
+	_, claimed, err := s.Reserve("user-1", "key-1", "fp-1", time.Minute)
+	if claimed {
+		t.Fatal("a second Reserve while in flight must not claim")
+	}
+	if !errors.Is(err, ErrInFlight) {
+		t.Fatalf("expected ErrInFlight, got %v", err)
+	}
+}
+
+func TestMemoryStoreReserveFingerprintMismatch(t *testing.T) {
+	s := NewMemoryStore()
+
+	if _, _, err := s.Reserve("user-1", "key-1", "fp-1", time.Minute); err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+	if err := s.Complete("user-1", "key-1", Record{Fingerprint: "fp-1", StatusCode: 201}, time.Minute); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+
+	_, claimed, err := s.Reserve("user-1", "key-1", "fp-2", time.Minute)
+	if claimed {
+		t.Fatal("reusing the key with a different body must not claim")
+	}
+	if !errors.Is(err, ErrFingerprintMismatch) {
+		t.Fatalf("expected ErrFingerprintMismatch, got %v", err)
+	}
+}
+
+func TestMemoryStoreReserveReplaysCompletedRecord(t *testing.T) {
+	s := NewMemoryStore()
+
+	if _, _, err := s.Reserve("user-1", "key-1", "fp-1", time.Minute); err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+	want := Record{Fingerprint: "fp-1", StatusCode: 201, Body: []byte(`{"id":"order-1"}`)}
+	if err := s.Complete("user-1", "key-1", want, time.Minute); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+
+	got, claimed, err := s.Reserve("user-1", "key-1", "fp-1", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if claimed {
+		t.Fatal("replaying a completed key must not claim it again")
+	}
+	if got.StatusCode != want.StatusCode || string(got.Body) != string(want.Body) {
+		t.Fatalf("replayed record = %+v, want %+v", got, want)
+	}
+}
+
+func TestMemoryStoreReleaseAllowsReclaim(t *testing.T) {
+	s := NewMemoryStore()
+
+	if _, _, err := s.Reserve("user-1", "key-1", "fp-1", time.Minute); err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+	if err := s.Release("user-1", "key-1"); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+
+	_, claimed, err := s.Reserve("user-1", "key-1", "fp-1", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !claimed {
+		t.Fatal("Reserve after Release should claim again")
+	}
+}
@@ -0,0 +1,93 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStore is the default Store in production: it is shared across all
+// gateway replicas, so a retry landing on a different instance still sees
+// the in-flight marker or the completed response.
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore builds a RedisStore against addr (host:port).
+func NewRedisStore(addr string) *RedisStore {
+	return &RedisStore{client: redis.NewClient(&redis.Options{Addr: addr})}
+}
+
+func redisKey(userID, key string) string {
+	return "idempotency:" + userID + ":" + key
+}
+
+type redisRecord struct {
+	Fingerprint string `json:"fingerprint"`
+	Done        bool   `json:"done"`
+	StatusCode  int    `json:"status_code,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// Reserve implements Store using SET NX as the atomic claim primitive.
+func (s *RedisStore) Reserve(userID, key, fingerprint string, leaseTTL time.Duration) (Record, bool, error) {
+	ctx := context.Background()
+	rk := redisKey(userID, key)
+
+	payload, err := json.Marshal(redisRecord{Fingerprint: fingerprint})
+	if err != nil {
+		return Record{}, false, err
+	}
+
+	ok, err := s.client.SetNX(ctx, rk, payload, leaseTTL).Result()
+	if err != nil {
+		return Record{}, false, err
+	}
+	if ok {
+		return Record{}, true, nil
+	}
+
+	raw, err := s.client.Get(ctx, rk).Bytes()
+	if err != nil {
+		return Record{}, false, err
+	}
+	var existing redisRecord
+	if err := json.Unmarshal(raw, &existing); err != nil {
+		return Record{}, false, err
+	}
+
+	if !existing.Done {
+		return Record{}, false, ErrInFlight
+	}
+	if existing.Fingerprint != fingerprint {
+		return Record{}, false, ErrFingerprintMismatch
+	}
+	return Record{
+		Fingerprint: existing.Fingerprint,
+		Done:        existing.Done,
+		StatusCode:  existing.StatusCode,
+		Body:        existing.Body,
+	}, false, nil
+}
+
+// Complete implements Store.
+func (s *RedisStore) Complete(userID, key string, record Record, window time.Duration) error {
+	record.Done = true
+	payload, err := json.Marshal(redisRecord{
+		Fingerprint: record.Fingerprint,
+		Done:        true,
+		StatusCode:  record.StatusCode,
+		Body:        record.Body,
+	})
+	if err != nil {
+		return err
+	}
+	return s.client.Set(context.Background(), redisKey(userID, key), payload, window).Err()
+}
+
+// Release implements Store.
+func (s *RedisStore) Release(userID, key string) error {
+	return s.client.Del(context.Background(), redisKey(userID, key)).Err()
+}
@@ -0,0 +1,49 @@
+// Package idempotency stores the outcome of requests keyed by a client
+// supplied Idempotency-Key, so middleware.IdempotencyMiddleware can replay
+// an identical retried request instead of re-executing it.
+package idempotency
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInFlight is returned by Reserve when another request with the same key
+// is still being processed.
+var ErrInFlight = errors.New("idempotency: request with this key is already in flight")
+
+// ErrFingerprintMismatch is returned by Reserve when the key was reused with
+// a different request body.
+var ErrFingerprintMismatch = errors.New("idempotency: key reused with a different request body")
+
+// Record is the stored outcome (or in-flight marker) for an idempotency key.
+type Record struct {
+	Fingerprint string // hash of the request body the key was first used with
+	Done        bool   // false while the original request is still executing
+	StatusCode  int
+	Body        []byte
+}
+
+// Store persists idempotency records keyed by (userID, key). Implementations
+// must make Reserve atomic: only one caller racing on the same key may ever
+// see claimed=true.
+type Store interface {
+	// Reserve attempts to claim (userID, key) for a new request with the
+	// given body fingerprint. If the key is unclaimed, it inserts an
+	// in-flight record with leaseTTL and returns (zero Record, true, nil).
+	// If the key already exists, it returns (zero Record, false,
+	// ErrInFlight) while the original is still executing, (zero Record,
+	// false, ErrFingerprintMismatch) for a completed record with a
+	// different fingerprint, and otherwise (completed record, false, nil),
+	// meaning: replay it.
+	Reserve(userID, key, fingerprint string, leaseTTL time.Duration) (existing Record, claimed bool, err error)
+
+	// Complete stores the final outcome for (userID, key), replacing the
+	// in-flight record, to be replayed for window.
+	Complete(userID, key string, record Record, window time.Duration) error
+
+	// Release removes the record for (userID, key) without storing a
+	// completed one, used when Complete fails so the key becomes
+	// retryable again instead of staying in flight until its lease ends.
+	Release(userID, key string) error
+}
@@ -1,12 +1,14 @@
 package middleware
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 
+	"github.com/ecommerce/be-api-gin/internal/auth"
 	"github.com/ecommerce/be-api-gin/internal/config"
 	"github.com/ecommerce/be-api-gin/internal/models"
 )
@@ -19,8 +21,52 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
-// AuthMiddleware creates a JWT authentication middleware
+// keyFunc resolves the verification key for a token via provider, dispatching
+// on the token's declared signing method and kid header so HS256, RS256, and
+// ES256 tokens are all accepted when the provider supports them.
+func keyFunc(provider auth.KeyProvider) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodHMAC, *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+		default:
+			return nil, fmt.Errorf("unsupported signing method %q", token.Method.Alg())
+		}
+
+		kid, _ := token.Header["kid"].(string)
+		return provider.Key(kid, token.Method)
+	}
+}
+
+// BuildKeyProvider constructs the auth.KeyProvider selected by cfg.JWTKeyMode.
+// Callers that install auth middleware on more than one route group should
+// call this once and share the result via AuthMiddlewareWithProvider /
+// OptionalAuthMiddlewareWithProvider — building a fresh provider per group
+// means a fresh JWKSProvider per group in JWKS mode, each with its own
+// background refresh goroutine and HTTP polling client that never stops.
+// A provider that fails to construct still yields a safe middleware: every
+// request is rejected as unauthorized instead of the gateway failing to
+// start.
+func BuildKeyProvider(cfg *config.Config) auth.KeyProvider {
+	provider, err := auth.NewProviderFromConfig(cfg)
+	if err != nil {
+		return brokenProvider{err: err}
+	}
+	return provider
+}
+
+// AuthMiddleware creates a JWT authentication middleware. The verification
+// key is resolved through a auth.KeyProvider built from cfg.JWTKeyMode, so
+// HS256 (static secret), RS256, and ES256 (JWKS-backed, rotating) tokens are
+// all supported without changing call sites. Prefer AuthMiddlewareWithProvider
+// plus BuildKeyProvider when installing auth on more than one route group.
 func AuthMiddleware(cfg *config.Config) gin.HandlerFunc {
+	return AuthMiddlewareWithProvider(BuildKeyProvider(cfg))
+}
+
+// AuthMiddlewareWithProvider is AuthMiddleware parameterized on an explicit
+// KeyProvider, letting callers (and tests) bypass config-driven provider
+// construction.
+func AuthMiddlewareWithProvider(provider auth.KeyProvider) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -45,13 +91,7 @@ func AuthMiddleware(cfg *config.Config) gin.HandlerFunc {
 
 		// Parse and validate token
 		claims := &Claims{}
-		token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-			// Validate signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, jwt.ErrSignatureInvalid
-			}
-			return []byte(cfg.JWTSecret), nil
-		})
+		token, err := jwt.ParseWithClaims(tokenString, claims, keyFunc(provider))
 
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
@@ -79,9 +119,25 @@ func AuthMiddleware(cfg *config.Config) gin.HandlerFunc {
 	}
 }
 
-// OptionalAuthMiddleware creates a middleware that authenticates if token is present
-// but doesn't require it
+// brokenProvider rejects every key lookup with the error that prevented the
+// real provider from being constructed.
+type brokenProvider struct{ err error }
+
+func (p brokenProvider) Key(string, jwt.SigningMethod) (interface{}, error) {
+	return nil, p.err
+}
+
+// OptionalAuthMiddleware creates a middleware that authenticates if token is
+// present but doesn't require it. Prefer OptionalAuthMiddlewareWithProvider
+// plus BuildKeyProvider when installing this on more than one route group.
 func OptionalAuthMiddleware(cfg *config.Config) gin.HandlerFunc {
+	return OptionalAuthMiddlewareWithProvider(BuildKeyProvider(cfg))
+}
+
+// OptionalAuthMiddlewareWithProvider is OptionalAuthMiddleware parameterized
+// on an explicit KeyProvider, letting callers share one provider across
+// route groups the way AuthMiddlewareWithProvider does.
+func OptionalAuthMiddlewareWithProvider(provider auth.KeyProvider) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -98,12 +154,7 @@ func OptionalAuthMiddleware(cfg *config.Config) gin.HandlerFunc {
 		tokenString := parts[1]
 
 		claims := &Claims{}
-		token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, jwt.ErrSignatureInvalid
-			}
-			return []byte(cfg.JWTSecret), nil
-		})
+		token, err := jwt.ParseWithClaims(tokenString, claims, keyFunc(provider))
 
 		if err == nil && token.Valid {
 			c.Set("userID", claims.UserID)
@@ -116,8 +167,10 @@ func OptionalAuthMiddleware(cfg *config.Config) gin.HandlerFunc {
 	}
 }
 
-// AdminMiddleware ensures the user has admin role
-func AdminMiddleware() gin.HandlerFunc {
+// AdminMiddleware ensures the authenticated user's role is one of
+// cfg.AdminRoles, rather than hardcoding "admin", so deployments can define
+// their own set of privileged roles for multi-tenant RBAC.
+func AdminMiddleware(cfg *config.Config) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		role, exists := c.Get("role")
 		if !exists {
@@ -128,7 +181,16 @@ func AdminMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		if role != "admin" {
+		roleStr, _ := role.(string)
+		allowed := false
+		for _, adminRole := range cfg.AdminRoles {
+			if roleStr == adminRole {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
 			c.AbortWithStatusJSON(http.StatusForbidden, models.ErrorResponse{
 				Error:   "Forbidden",
 				Message: "Admin access required",
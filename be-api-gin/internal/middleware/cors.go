@@ -1,12 +1,15 @@
 package middleware
 
 import (
+	"crypto/rand"
+	"fmt"
 	"net/http"
-	"strings"
+	"regexp"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/ecommerce/be-api-gin/internal/config"
+	grpcclient "github.com/ecommerce/be-api-gin/pkg/grpc"
 )
 
 // CORSMiddleware creates a CORS middleware with the given configuration
@@ -66,32 +69,42 @@ func SecurityHeadersMiddleware() gin.HandlerFunc {
 	}
 }
 
-// RequestIDMiddleware adds a unique request ID to each request
+// incomingRequestIDPattern restricts client-supplied X-Request-ID values to a
+// safe, log-injection-proof format: 1-64 chars of UUID-safe characters.
+var incomingRequestIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// RequestIDMiddleware adds a unique request ID to each request and
+// propagates it into the request's context so outbound gRPC calls can attach
+// it as x-request-id metadata (see grpcclient.ContextWithRequestID).
 func RequestIDMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		requestID := c.GetHeader("X-Request-ID")
-		if requestID == "" {
+		if requestID == "" || !incomingRequestIDPattern.MatchString(requestID) {
 			requestID = generateRequestID()
 		}
 
 		c.Set("requestID", requestID)
 		c.Header("X-Request-ID", requestID)
+		c.Request = c.Request.WithContext(grpcclient.ContextWithRequestID(c.Request.Context(), requestID))
 
 		c.Next()
 	}
 }
 
-// generateRequestID generates a simple unique request ID
+// generateRequestID generates a "req-"-prefixed UUIDv4 using crypto/rand.
 func generateRequestID() string {
-	// Simple implementation - in production use UUID
-	const charset = "abcdefghijklmnopqrstuvwxyz0123456789"
-	var sb strings.Builder
-	sb.WriteString("req-")
-	// Using a simple approach - in production use crypto/rand
-	for i := 0; i < 16; i++ {
-		sb.WriteByte(charset[i%len(charset)])
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		// crypto/rand failures are effectively unrecoverable on any
+		// supported platform; fall back to a fixed, clearly-bogus ID
+		// rather than panicking mid-request.
+		return "req-00000000-0000-0000-0000-000000000000"
 	}
-	return sb.String()
+
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	return fmt.Sprintf("req-%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
 }
 
 // RecoveryMiddleware recovers from panics and returns a 500 error
@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ecommerce/be-api-gin/internal/config"
+	"github.com/ecommerce/be-api-gin/internal/idempotency"
+	"github.com/ecommerce/be-api-gin/internal/models"
+)
+
+// responseBuffer captures the status code and body a handler writes so it
+// can be persisted for later replay, while still forwarding everything to
+// the real gin.ResponseWriter.
+type responseBuffer struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *responseBuffer) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseBuffer) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// IdempotencyMiddleware makes the decorated route safe to retry: a request
+// carrying an Idempotency-Key header is executed at most once per
+// (userID, key); retries with the same key and body replay the original
+// response verbatim, and retries with the same key but a different body are
+// rejected. It must run after an auth middleware that sets "userID".
+func IdempotencyMiddleware(cfg *config.Config, store idempotency.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader("Idempotency-Key")
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		userIDVal, _ := c.Get("userID")
+		userID, _ := userIDVal.(string)
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "Invalid request body",
+				Message: err.Error(),
+			})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		fingerprint := fingerprintOf(body)
+
+		existing, claimed, err := store.Reserve(userID, key, fingerprint, cfg.IdempotencyLeaseTTL)
+		switch {
+		case errors.Is(err, idempotency.ErrInFlight):
+			c.AbortWithStatusJSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "Request in progress",
+				Message: "A request with this Idempotency-Key is already being processed",
+			})
+			return
+		case errors.Is(err, idempotency.ErrFingerprintMismatch):
+			c.AbortWithStatusJSON(http.StatusUnprocessableEntity, models.ErrorResponse{
+				Error:   "Idempotency key reused",
+				Message: "This Idempotency-Key was already used with a different request body",
+			})
+			return
+		case err != nil:
+			c.AbortWithStatusJSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "Idempotency check failed",
+				Message: err.Error(),
+			})
+			return
+		case !claimed:
+			// Completed record with a matching fingerprint: replay it.
+			c.Data(existing.StatusCode, gin.MIMEJSON, existing.Body)
+			c.Abort()
+			return
+		}
+
+		buf := &responseBuffer{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = buf
+		c.Next()
+
+		if err := store.Complete(userID, key, idempotency.Record{
+			Fingerprint: fingerprint,
+			StatusCode:  buf.status,
+			Body:        buf.body.Bytes(),
+		}, cfg.IdempotencyWindow); err != nil {
+			// The handler already ran and its response was already flushed
+			// to the client; a failure to persist the record only means a
+			// subsequent retry will re-execute rather than replay.
+			_ = store.Release(userID, key)
+		}
+	}
+}
+
+func fingerprintOf(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
@@ -30,6 +30,24 @@ type ProductsResponse struct {
 	Total      int64      `json:"total"`
 }
 
+// ProductSearch represents the query parameters ListProducts accepts, bound
+// with c.ShouldBindWith(&f, binding.Form) so it covers both the legacy
+// page/limit pair and the richer offset/count/sort/filter surface.
+type ProductSearch struct {
+	Page        int      `form:"page"`
+	Limit       int      `form:"limit"`
+	Offset      int      `form:"offset"` // takes precedence over Page when set
+	Count       int      `form:"count"`  // takes precedence over Limit when set; capped server-side
+	Category    string   `form:"category"`
+	Search      string   `form:"search"`
+	SortColumn  string   `form:"sort_column"` // name, price, created_at, popularity
+	SortOrder   string   `form:"sort_order"`  // asc or desc
+	MinPrice    float64  `form:"min_price"`
+	MaxPrice    float64  `form:"max_price"`
+	Tags        []string `form:"tags"`
+	InStockOnly bool     `form:"in_stock_only"`
+}
+
 // Product represents a product
 type Product struct {
 	ID          string    `json:"id"`
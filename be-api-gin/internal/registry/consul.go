@@ -0,0 +1,128 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ecommerce/be-api-gin/internal/config"
+)
+
+// ConsulRegistry resolves service endpoints from Consul's HTTP catalog API
+// and watches for changes using Consul's blocking queries (the `index`
+// query parameter), so updates are pushed as soon as Consul's catalog
+// changes rather than on a fixed poll interval.
+type ConsulRegistry struct {
+	addr   string
+	client *http.Client
+}
+
+// NewConsulRegistry builds a ConsulRegistry pointed at cfg.ConsulAddr.
+func NewConsulRegistry(cfg *config.Config) (*ConsulRegistry, error) {
+	if cfg.ConsulAddr == "" {
+		return nil, fmt.Errorf("registry: CONSUL_ADDR is required when REGISTRY_DRIVER=consul")
+	}
+	return &ConsulRegistry{
+		addr:   cfg.ConsulAddr,
+		client: &http.Client{Timeout: 65 * time.Second},
+	}, nil
+}
+
+type consulServiceEntry struct {
+	Address string
+	Port    int
+}
+
+func (r *ConsulRegistry) catalogQuery(service string, index uint64) ([]consulServiceEntry, uint64, error) {
+	q := url.Values{}
+	if index > 0 {
+		q.Set("index", strconv.FormatUint(index, 10))
+		q.Set("wait", "60s")
+	}
+	reqURL := fmt.Sprintf("%s/v1/catalog/service/%s?%s", r.addr, url.PathEscape(service), q.Encode())
+
+	resp, err := r.client.Get(reqURL)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("registry: consul catalog query for %q failed with status %d", service, resp.StatusCode)
+	}
+
+	var entries []consulServiceEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return nil, 0, err
+	}
+
+	newIndex, _ := strconv.ParseUint(resp.Header.Get("X-Consul-Index"), 10, 64)
+	return entries, newIndex, nil
+}
+
+// Resolve implements Registry.
+func (r *ConsulRegistry) Resolve(service string) ([]Endpoint, error) {
+	entries, _, err := r.catalogQuery(service, 0)
+	if err != nil {
+		return nil, err
+	}
+	return toEndpoints(entries), nil
+}
+
+// Watch implements Registry, issuing Consul blocking queries in a background
+// goroutine and pushing the updated endpoint set whenever the catalog index
+// advances. The goroutine exits once ctx is canceled.
+func (r *ConsulRegistry) Watch(ctx context.Context, service string) (<-chan []Endpoint, error) {
+	entries, index, err := r.catalogQuery(service, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan []Endpoint, 1)
+	ch <- toEndpoints(entries)
+
+	go func() {
+		defer close(ch)
+		for {
+			entries, newIndex, err := r.catalogQuery(service, index)
+			if err != nil {
+				// Back off briefly so a persistently unreachable Consul
+				// agent doesn't spin the loop.
+				select {
+				case <-time.After(5 * time.Second):
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+			if newIndex == index {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+					continue
+				}
+			}
+			index = newIndex
+			select {
+			case ch <- toEndpoints(entries):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func toEndpoints(entries []consulServiceEntry) []Endpoint {
+	endpoints := make([]Endpoint, 0, len(entries))
+	for _, e := range entries {
+		endpoints = append(endpoints, Endpoint{Address: fmt.Sprintf("%s:%d", e.Address, e.Port)})
+	}
+	return endpoints
+}
@@ -0,0 +1,86 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/ecommerce/be-api-gin/internal/config"
+)
+
+// EtcdRegistry resolves service endpoints from etcd keys under
+// /services/<name>/<instance>, where each key's value is a host:port
+// address. It watches that prefix for changes.
+type EtcdRegistry struct {
+	client *clientv3.Client
+}
+
+// NewEtcdRegistry builds an EtcdRegistry connected to cfg.EtcdEndpoints.
+func NewEtcdRegistry(cfg *config.Config) (*EtcdRegistry, error) {
+	if len(cfg.EtcdEndpoints) == 0 {
+		return nil, fmt.Errorf("registry: ETCD_ENDPOINTS is required when REGISTRY_DRIVER=etcd")
+	}
+
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   cfg.EtcdEndpoints,
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &EtcdRegistry{client: client}, nil
+}
+
+func servicePrefix(service string) string {
+	return "/services/" + service + "/"
+}
+
+// Resolve implements Registry.
+func (r *EtcdRegistry) Resolve(service string) ([]Endpoint, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := r.client.Get(ctx, servicePrefix(service), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]Endpoint, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		endpoints = append(endpoints, Endpoint{Address: string(kv.Value)})
+	}
+	return endpoints, nil
+}
+
+// Watch implements Registry, streaming etcd watch events for the service's
+// key prefix and re-resolving the full endpoint set on every change. The
+// watch, and the goroutine forwarding it, stop once ctx is canceled.
+func (r *EtcdRegistry) Watch(ctx context.Context, service string) (<-chan []Endpoint, error) {
+	endpoints, err := r.Resolve(service)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan []Endpoint, 1)
+	ch <- endpoints
+
+	watchCh := r.client.Watch(ctx, servicePrefix(service), clientv3.WithPrefix())
+	go func() {
+		defer close(ch)
+		for range watchCh {
+			next, err := r.Resolve(service)
+			if err != nil {
+				continue
+			}
+			select {
+			case ch <- next:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
@@ -0,0 +1,51 @@
+// Package registry abstracts service discovery for the gRPC clients so that
+// endpoints can be resolved dynamically instead of read once from static
+// config. The driver is selected at startup via the REGISTRY_DRIVER env var.
+package registry
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ecommerce/be-api-gin/internal/config"
+)
+
+// ErrUnknownService is returned by Resolve/Watch when a driver has no
+// endpoints configured for the requested service name.
+var ErrUnknownService = errors.New("registry: unknown service")
+
+// Endpoint is a single resolvable instance of a service.
+type Endpoint struct {
+	Address string // host:port
+	Weight  int32  // relative load-balancing weight, 0 means "unweighted"
+}
+
+// Registry resolves a service name to its current set of endpoints and can
+// push updates as that set changes.
+type Registry interface {
+	// Resolve returns the current known endpoints for service.
+	Resolve(service string) ([]Endpoint, error)
+
+	// Watch returns a channel that receives the full endpoint set for
+	// service every time it changes. The channel is closed, and any
+	// background polling/streaming it depends on stopped, once ctx is
+	// canceled.
+	Watch(ctx context.Context, service string) (<-chan []Endpoint, error)
+}
+
+// New builds the Registry selected by cfg.RegistryDriver ("static" by
+// default). Unknown drivers fall back to "static" so misconfiguration
+// degrades to the previous fixed-address behavior rather than failing to
+// start.
+func New(cfg *config.Config) (Registry, error) {
+	switch cfg.RegistryDriver {
+	case "consul":
+		return NewConsulRegistry(cfg)
+	case "etcd":
+		return NewEtcdRegistry(cfg)
+	case "static", "":
+		return NewStaticRegistry(cfg), nil
+	default:
+		return NewStaticRegistry(cfg), nil
+	}
+}
@@ -0,0 +1,82 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/resolver"
+)
+
+// Scheme is the gRPC target scheme routed through a registry-backed
+// resolver, e.g. "registry:///user-service".
+const Scheme = "registry"
+
+// resolverBuilder adapts a Registry into gRPC's resolver.Builder interface
+// so that grpc.ClientConn picks up endpoint changes pushed by Registry.Watch
+// and rebalances across them.
+type resolverBuilder struct {
+	reg Registry
+}
+
+// NewResolverBuilder wraps reg as a resolver.Builder that can be registered
+// globally with resolver.Register so "registry:///<service>" targets resolve
+// through it.
+func NewResolverBuilder(reg Registry) resolver.Builder {
+	return &resolverBuilder{reg: reg}
+}
+
+// Scheme implements resolver.Builder.
+func (b *resolverBuilder) Scheme() string {
+	return Scheme
+}
+
+// Build implements resolver.Builder, starting a goroutine that forwards
+// Registry.Watch updates for the target's service name to the gRPC
+// ClientConn until the resolver is closed.
+func (b *resolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	service := target.Endpoint()
+	if service == "" {
+		return nil, fmt.Errorf("registry: empty service name in target %q", target.URL.String())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, err := b.reg.Watch(ctx, service)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	r := &registryResolver{cc: cc, cancel: cancel}
+	go r.run(updates)
+	return r, nil
+}
+
+type registryResolver struct {
+	cc     resolver.ClientConn
+	cancel context.CancelFunc
+}
+
+func (r *registryResolver) run(updates <-chan []Endpoint) {
+	for endpoints := range updates {
+		r.cc.UpdateState(resolver.State{Addresses: toResolverAddresses(endpoints)})
+	}
+}
+
+func toResolverAddresses(endpoints []Endpoint) []resolver.Address {
+	addrs := make([]resolver.Address, 0, len(endpoints))
+	for _, e := range endpoints {
+		addrs = append(addrs, resolver.Address{Addr: e.Address})
+	}
+	return addrs
+}
+
+// ResolveNow implements resolver.Resolver. Updates are already pushed
+// continuously by Watch, so there is nothing additional to do here.
+func (r *registryResolver) ResolveNow(resolver.ResolveNowOptions) {}
+
+// Close implements resolver.Resolver. Canceling the context stops the
+// Registry's background watch goroutine (polling or streaming) and closes
+// the updates channel, which in turn ends run's range loop.
+func (r *registryResolver) Close() {
+	r.cancel()
+}
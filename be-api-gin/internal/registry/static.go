@@ -0,0 +1,49 @@
+package registry
+
+import (
+	"context"
+
+	"github.com/ecommerce/be-api-gin/internal/config"
+)
+
+// StaticRegistry resolves the fixed addresses from config.Config, mirroring
+// the behavior the clients had before dynamic discovery existed. Watch never
+// pushes further updates since the address set cannot change at runtime.
+type StaticRegistry struct {
+	endpoints map[string][]Endpoint
+}
+
+// NewStaticRegistry builds a StaticRegistry from the service addresses in cfg.
+func NewStaticRegistry(cfg *config.Config) *StaticRegistry {
+	return &StaticRegistry{
+		endpoints: map[string][]Endpoint{
+			"user-service":      {{Address: cfg.UserServiceAddr}},
+			"listing-service":   {{Address: cfg.ListingServiceAddr}},
+			"inventory-service": {{Address: cfg.InventoryServiceAddr}},
+		},
+	}
+}
+
+// Resolve implements Registry.
+func (r *StaticRegistry) Resolve(service string) ([]Endpoint, error) {
+	endpoints, ok := r.endpoints[service]
+	if !ok {
+		return nil, ErrUnknownService
+	}
+	return endpoints, nil
+}
+
+// Watch implements Registry. The static driver has nothing to watch, so it
+// returns a channel that immediately receives the current endpoints once and
+// is then closed.
+func (r *StaticRegistry) Watch(_ context.Context, service string) (<-chan []Endpoint, error) {
+	endpoints, err := r.Resolve(service)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan []Endpoint, 1)
+	ch <- endpoints
+	close(ch)
+	return ch, nil
+}
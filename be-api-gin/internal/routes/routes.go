@@ -1,14 +1,21 @@
 package routes
 
 import (
+	"context"
+	"fmt"
+	"log"
 	"net/http"
+	"os"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/ecommerce/be-api-gin/internal/auth"
 	"github.com/ecommerce/be-api-gin/internal/config"
 	"github.com/ecommerce/be-api-gin/internal/handlers"
+	"github.com/ecommerce/be-api-gin/internal/idempotency"
 	"github.com/ecommerce/be-api-gin/internal/middleware"
 	grpcclient "github.com/ecommerce/be-api-gin/pkg/grpc"
+	"github.com/ecommerce/be-api-gin/pkg/saga"
 )
 
 // Setup configures all routes and returns the router
@@ -26,9 +33,34 @@ func Setup(cfg *config.Config, grpcClients *grpcclient.Clients) *gin.Engine {
 	router.GET("/health", healthCheck)
 	router.GET("/ready", readinessCheck(grpcClients))
 
+	// Publish this service's own signing keys when it verifies tokens
+	// against a local RSA keypair, so dependents can fetch and cache them.
+	if cfg.JWTKeyMode == "rsa-local" {
+		provider, err := auth.NewProviderFromConfig(cfg)
+		if err != nil {
+			log.Printf("Warning: failed to load local RSA key for JWKS endpoint: %v", err)
+		} else {
+			router.GET("/.well-known/jwks.json", gin.WrapF(auth.JWKSHandler(provider)))
+		}
+	}
+
+	// Built once and shared across every route group below: a fresh
+	// auth.KeyProvider per group would mean a fresh JWKSProvider (and its
+	// background refresh goroutine and HTTP polling client) per group in
+	// JWKS mode.
+	authProvider := middleware.BuildKeyProvider(cfg)
+
 	// Initialize handlers
-	productHandler := handlers.NewProductHandler(grpcClients)
-	orderHandler := handlers.NewOrderHandler(grpcClients)
+	productHandler := handlers.NewProductHandler(grpcClients, cfg)
+	sagaStore := orderSagaStore(cfg)
+	orderHandler := handlers.NewOrderHandlerWithSagaStore(grpcClients, sagaStore, cfg)
+
+	// A crashed replica's in-flight orders are picked up by whichever
+	// replica's reaper notices the lease expired first.
+	reaper := saga.NewReaper(orderHandler.Sagas(), sagaStore, reaperOwnerID(), cfg.SagaReaperInterval)
+	go reaper.Run(context.Background())
+
+	idempotent := middleware.IdempotencyMiddleware(cfg, idempotencyStore(cfg))
 
 	// Setup product and order routes function
 	setupAPIRoutes := func(apiGroup *gin.RouterGroup) {
@@ -40,21 +72,22 @@ func Setup(cfg *config.Config, grpcClients *grpcclient.Clients) *gin.Engine {
 			products.GET("/:id", productHandler.GetProduct)
 
 			// Protected routes
-			products.POST("", middleware.AuthMiddleware(cfg), productHandler.CreateProduct)
-			products.PUT("/:id", middleware.AuthMiddleware(cfg), productHandler.UpdateProduct)
-			products.DELETE("/:id", middleware.AuthMiddleware(cfg), productHandler.DeleteProduct)
-			products.PUT("/:id/inventory", middleware.AuthMiddleware(cfg), productHandler.UpdateInventory)
+			products.POST("", middleware.AuthMiddlewareWithProvider(authProvider), idempotent, productHandler.CreateProduct)
+			products.PUT("/:id", middleware.AuthMiddlewareWithProvider(authProvider), productHandler.UpdateProduct)
+			products.DELETE("/:id", middleware.AuthMiddlewareWithProvider(authProvider), productHandler.DeleteProduct)
+			products.PUT("/:id/inventory", middleware.AuthMiddlewareWithProvider(authProvider), productHandler.UpdateInventory)
 		}
 
 		// Order routes (all protected)
 		orders := apiGroup.Group("/orders")
-		orders.Use(middleware.AuthMiddleware(cfg))
+		orders.Use(middleware.AuthMiddlewareWithProvider(authProvider))
 		{
 			orders.GET("", orderHandler.ListOrders)
 			orders.GET("/:id", orderHandler.GetOrder)
-			orders.POST("", orderHandler.CreateOrder)
+			orders.POST("", idempotent, orderHandler.CreateOrder)
 			orders.PUT("/:id/status", orderHandler.UpdateOrderStatus)
 			orders.DELETE("/:id", orderHandler.CancelOrder)
+			orders.GET("/:id/saga", orderHandler.GetOrderSaga)
 		}
 	}
 
@@ -85,6 +118,36 @@ func Setup(cfg *config.Config, grpcClients *grpcclient.Clients) *gin.Engine {
 	return router
 }
 
+// idempotencyStore builds the backing store for IdempotencyMiddleware
+// according to cfg.IdempotencyStoreDriver, defaulting to Redis so the
+// replay guarantee holds across gateway replicas.
+func idempotencyStore(cfg *config.Config) idempotency.Store {
+	if cfg.IdempotencyStoreDriver == "memory" {
+		return idempotency.NewMemoryStore()
+	}
+	return idempotency.NewRedisStore(cfg.RedisAddr)
+}
+
+// orderSagaStore builds the backing store for the CreateOrder saga
+// according to cfg.SagaStoreDriver, defaulting to Redis so an order
+// survives the gateway replica that started it crashing mid-checkout.
+func orderSagaStore(cfg *config.Config) saga.Store {
+	if cfg.SagaStoreDriver == "memory" {
+		return saga.NewMemoryStore()
+	}
+	return saga.NewRedisStore(cfg.RedisAddr)
+}
+
+// reaperOwnerID identifies this process in a saga's LeaseOwner field, for
+// operators correlating a stuck saga back to the replica that last touched it.
+func reaperOwnerID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
 // healthCheck returns the health status of the service
 func healthCheck(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{
@@ -0,0 +1,27 @@
+package grpc
+
+import (
+	"math/rand"
+	"time"
+)
+
+// nextBackoff doubles attempt over attempt starting at initial, capped at
+// max, and adds up to 20% jitter so that many clients reconnecting at once
+// don't all retry in lockstep.
+func nextBackoff(attempt int, initial, max time.Duration) time.Duration {
+	if attempt < 0 {
+		attempt = 0
+	}
+
+	backoff := initial
+	for i := 0; i < attempt; i++ {
+		backoff *= 2
+		if backoff >= max {
+			backoff = max
+			break
+		}
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(backoff) / 5 + 1))
+	return backoff + jitter
+}
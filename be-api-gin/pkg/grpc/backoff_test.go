@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextBackoffDoublesUpToMax(t *testing.T) {
+	initial := 100 * time.Millisecond
+	max := 2 * time.Second
+
+	cases := []struct {
+		attempt  int
+		wantBase time.Duration
+	}{
+		{attempt: 0, wantBase: 100 * time.Millisecond},
+		{attempt: 1, wantBase: 200 * time.Millisecond},
+		{attempt: 2, wantBase: 400 * time.Millisecond},
+		{attempt: 3, wantBase: 800 * time.Millisecond},
+		{attempt: 10, wantBase: max}, // capped well before attempt 10
+	}
+
+	for _, tc := range cases {
+		for i := 0; i < 50; i++ {
+			got := nextBackoff(tc.attempt, initial, max)
+			if got < tc.wantBase {
+				t.Fatalf("attempt %d: backoff %v below base %v", tc.attempt, got, tc.wantBase)
+			}
+			if got > tc.wantBase+tc.wantBase/5 {
+				t.Fatalf("attempt %d: backoff %v exceeds base+20%% jitter (%v)", tc.attempt, got, tc.wantBase+tc.wantBase/5)
+			}
+		}
+	}
+}
+
+func TestNextBackoffNegativeAttemptClampsToZero(t *testing.T) {
+	initial := 100 * time.Millisecond
+	max := 2 * time.Second
+
+	got := nextBackoff(-1, initial, max)
+	if got < initial || got > initial+initial/5 {
+		t.Fatalf("negative attempt should behave like attempt 0, got %v", got)
+	}
+}
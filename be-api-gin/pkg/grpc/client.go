@@ -8,64 +8,242 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/resolver"
 	"google.golang.org/grpc/status"
 
 	"github.com/ecommerce/be-api-gin/internal/config"
 	"github.com/ecommerce/be-api-gin/internal/models"
+	"github.com/ecommerce/be-api-gin/internal/registry"
+	"github.com/ecommerce/be-api-gin/proto/gen/inventorypb"
+	"github.com/ecommerce/be-api-gin/proto/gen/listingpb"
+	"github.com/ecommerce/be-api-gin/proto/gen/userpb"
 )
 
-// Common errors
-var (
-	ErrNotFound     = errors.New("resource not found")
-	ErrUnauthorized = errors.New("unauthorized")
-	ErrInternal     = errors.New("internal error")
-)
+// ErrInternal guards calls made before a connection's client was set up
+// (e.g. NewClients partially failed). Errors from an actual RPC are
+// translated by handleGRPCError/TranslateError into an *apierr.Error
+// instead.
+var ErrInternal = errors.New("internal error")
 
 // Clients holds all gRPC client connections
 type Clients struct {
 	userConn      *grpc.ClientConn
 	listingConn   *grpc.ClientConn
 	inventoryConn *grpc.ClientConn
-	config        *config.Config
+
+	userClient      userpb.UserServiceClient
+	listingClient   listingpb.ListingServiceClient
+	inventoryClient inventorypb.InventoryServiceClient
+
+	config *config.Config
 }
 
-// NewClients creates and initializes all gRPC client connections
+// NewClientsFromConfig is the entry point callers should use to build
+// Clients: it dials cfg's fixed addresses directly when cfg.RegistryDriver
+// is "static" (or unset), and otherwise builds the driver cfg selects via
+// registry.New and resolves endpoints dynamically through it via
+// NewClientsWithRegistry.
+func NewClientsFromConfig(cfg *config.Config) (*Clients, error) {
+	if cfg.RegistryDriver == "" || cfg.RegistryDriver == "static" {
+		return NewClients(cfg)
+	}
+
+	reg, err := registry.New(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewClientsWithRegistry(cfg, reg)
+}
+
+// NewClients creates and initializes all gRPC client connections. Dialing is
+// non-blocking: connections are created immediately and connect lazily in
+// the background, with a reconnect loop that applies exponential backoff
+// whenever a connection drops into TRANSIENT_FAILURE.
 func NewClients(cfg *config.Config) (*Clients, error) {
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                cfg.GRPCKeepaliveInterval,
+			Timeout:             cfg.GRPCKeepaliveInterval / 2,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithChainUnaryInterceptor(requestIDUnaryClientInterceptor, retryUnaryInterceptor(cfg)),
 	}
 
-	// Context with timeout for connection
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	userConn, err := grpc.NewClient(cfg.UserServiceAddr, opts...)
+	if err != nil {
+		log.Printf("Warning: Failed to create user service client for %s: %v", cfg.UserServiceAddr, err)
+	}
 
-	// Connect to User Service
-	userConn, err := grpc.DialContext(ctx, cfg.UserServiceAddr, opts...)
+	listingConn, err := grpc.NewClient(cfg.ListingServiceAddr, opts...)
 	if err != nil {
-		log.Printf("Warning: Failed to connect to user service at %s: %v", cfg.UserServiceAddr, err)
-		// Don't fail - service might not be available yet
+		log.Printf("Warning: Failed to create listing service client for %s: %v", cfg.ListingServiceAddr, err)
 	}
 
-	// Connect to Listing Service
-	listingConn, err := grpc.DialContext(ctx, cfg.ListingServiceAddr, opts...)
+	inventoryConn, err := grpc.NewClient(cfg.InventoryServiceAddr, opts...)
 	if err != nil {
-		log.Printf("Warning: Failed to connect to listing service at %s: %v", cfg.ListingServiceAddr, err)
+		log.Printf("Warning: Failed to create inventory service client for %s: %v", cfg.InventoryServiceAddr, err)
+	}
+
+	clients := &Clients{
+		userConn:      userConn,
+		listingConn:   listingConn,
+		inventoryConn: inventoryConn,
+		config:        cfg,
+	}
+	if userConn != nil {
+		clients.userClient = userpb.NewUserServiceClient(userConn)
+		go reconnectLoop("user-service", userConn, cfg)
+	}
+	if listingConn != nil {
+		clients.listingClient = listingpb.NewListingServiceClient(listingConn)
+		go reconnectLoop("listing-service", listingConn, cfg)
+	}
+	if inventoryConn != nil {
+		clients.inventoryClient = inventorypb.NewInventoryServiceClient(inventoryConn)
+		go reconnectLoop("inventory-service", inventoryConn, cfg)
+	}
+
+	return clients, nil
+}
+
+// reconnectLoop watches a connection's state for its lifetime and, whenever
+// it settles into TRANSIENT_FAILURE, nudges it to redial with exponential
+// backoff instead of waiting on gRPC's own (much more conservative) default
+// backoff policy. It exits once the connection is closed (state transitions
+// to Shutdown never resolve).
+func reconnectLoop(name string, conn *grpc.ClientConn, cfg *config.Config) {
+	attempt := 0
+	for {
+		state := conn.GetState()
+		if state == connectivity.Shutdown {
+			return
+		}
+
+		if state == connectivity.TransientFailure {
+			backoff := nextBackoff(attempt, cfg.GRPCInitialBackoff, cfg.GRPCMaxBackoff)
+			if cfg.GRPCMaxRetries > 0 && attempt >= cfg.GRPCMaxRetries {
+				log.Printf("gRPC %s: giving up reconnecting after %d attempts, will retry on next health check", name, attempt)
+			} else {
+				time.Sleep(backoff)
+				conn.Connect()
+				attempt++
+				continue
+			}
+		} else {
+			attempt = 0
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.GRPCMaxBackoff)
+		conn.WaitForStateChange(ctx, state)
+		cancel()
 	}
+}
 
-	// Connect to Inventory Service
-	inventoryConn, err := grpc.DialContext(ctx, cfg.InventoryServiceAddr, opts...)
+// retryUnaryInterceptor retries a unary call with exponential backoff, up to
+// cfg.GRPCMaxRetries attempts, but only on codes.Unavailable. Unavailable
+// means the RPC never reached the server (the channel couldn't be used), so
+// it's always safe to resend. DeadlineExceeded is deliberately excluded: it
+// means we don't know whether the server already executed the call, and
+// blindly retrying a non-idempotent method like ReserveInventory or
+// CreateOrder on it can double-execute. Callers that know a given method is
+// idempotent and safe to retry past a deadline should do so explicitly at
+// the call site, not here.
+func retryUnaryInterceptor(cfg *config.Config) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var lastErr error
+		for attempt := 0; attempt <= cfg.GRPCMaxRetries; attempt++ {
+			lastErr = invoker(ctx, method, req, reply, cc, opts...)
+			if lastErr == nil {
+				return nil
+			}
+
+			st, ok := status.FromError(lastErr)
+			if !ok || st.Code() != codes.Unavailable {
+				return lastErr
+			}
+			if attempt == cfg.GRPCMaxRetries {
+				break
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(nextBackoff(attempt, cfg.GRPCInitialBackoff, cfg.GRPCMaxBackoff)):
+			}
+		}
+		return lastErr
+	}
+}
+
+// NewClientsWithRegistry is like NewClients but resolves service addresses
+// dynamically through reg instead of dialing cfg's fixed addresses directly.
+// Each service is dialed against a "registry:///<service-name>" target so
+// that gRPC's own load-balancing and rebalancing apply as reg pushes updated
+// endpoint sets.
+func NewClientsWithRegistry(cfg *config.Config, reg registry.Registry) (*Clients, error) {
+	resolver.Register(registry.NewResolverBuilder(reg))
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                cfg.GRPCKeepaliveInterval,
+			Timeout:             cfg.GRPCKeepaliveInterval / 2,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithChainUnaryInterceptor(requestIDUnaryClientInterceptor, retryUnaryInterceptor(cfg)),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy":"round_robin"}`),
+	}
+
+	userConn, err := grpc.NewClient(registry.Scheme+":///user-service", opts...)
+	if err != nil {
+		log.Printf("Warning: Failed to create user service client via registry: %v", err)
+	}
+	listingConn, err := grpc.NewClient(registry.Scheme+":///listing-service", opts...)
+	if err != nil {
+		log.Printf("Warning: Failed to create listing service client via registry: %v", err)
+	}
+	inventoryConn, err := grpc.NewClient(registry.Scheme+":///inventory-service", opts...)
 	if err != nil {
-		log.Printf("Warning: Failed to connect to inventory service at %s: %v", cfg.InventoryServiceAddr, err)
+		log.Printf("Warning: Failed to create inventory service client via registry: %v", err)
 	}
 
-	return &Clients{
+	clients := &Clients{
 		userConn:      userConn,
 		listingConn:   listingConn,
 		inventoryConn: inventoryConn,
 		config:        cfg,
-	}, nil
+	}
+	if userConn != nil {
+		clients.userClient = userpb.NewUserServiceClient(userConn)
+		go reconnectLoop("user-service", userConn, cfg)
+	}
+	if listingConn != nil {
+		clients.listingClient = listingpb.NewListingServiceClient(listingConn)
+		go reconnectLoop("listing-service", listingConn, cfg)
+	}
+	if inventoryConn != nil {
+		clients.inventoryClient = inventorypb.NewInventoryServiceClient(inventoryConn)
+		go reconnectLoop("inventory-service", inventoryConn, cfg)
+	}
+
+	return clients, nil
+}
+
+// WithClients builds a Clients value directly from already-constructed stub
+// interfaces, bypassing dialing entirely. This lets tests and other callers
+// inject fakes for userpb.UserServiceClient, listingpb.ListingServiceClient,
+// and inventorypb.InventoryServiceClient without a live gRPC server.
+func WithClients(cfg *config.Config, userClient userpb.UserServiceClient, listingClient listingpb.ListingServiceClient, inventoryClient inventorypb.InventoryServiceClient) *Clients {
+	return &Clients{
+		config:          cfg,
+		userClient:      userClient,
+		listingClient:   listingClient,
+		inventoryClient: inventoryClient,
+	}
 }
 
 // Close closes all gRPC connections
@@ -81,208 +259,400 @@ func (c *Clients) Close() {
 	}
 }
 
-// HealthCheck checks the health of all connected services
+// HealthCheck checks the health of all connected services. A connection
+// found in TRANSIENT_FAILURE is kicked to reconnect immediately rather than
+// waiting for the background reconnect loop's current backoff to elapse.
 func (c *Clients) HealthCheck(ctx context.Context) map[string]bool {
 	return map[string]bool{
-		"user-service":      c.userConn != nil && c.userConn.GetState().String() == "READY",
-		"listing-service":   c.listingConn != nil && c.listingConn.GetState().String() == "READY",
-		"inventory-service": c.inventoryConn != nil && c.inventoryConn.GetState().String() == "READY",
+		"user-service":      checkAndKick(c.userConn),
+		"listing-service":   checkAndKick(c.listingConn),
+		"inventory-service": checkAndKick(c.inventoryConn),
 	}
 }
 
-// handleGRPCError converts gRPC errors to application errors
+func checkAndKick(conn *grpc.ClientConn) bool {
+	if conn == nil {
+		return false
+	}
+	state := conn.GetState()
+	if state == connectivity.TransientFailure {
+		conn.Connect()
+	}
+	return state == connectivity.Ready
+}
+
+// handleGRPCError converts a gRPC call error into the apierr.Error the
+// handlers package renders via RespondError. See TranslateError for the
+// actual code mapping.
 func handleGRPCError(err error) error {
 	if err == nil {
 		return nil
 	}
+	return TranslateError(err)
+}
+
+// --- conversion helpers ---
 
-	st, ok := status.FromError(err)
-	if !ok {
-		return err
+func productFromProto(p *listingpb.Product) *models.Product {
+	if p == nil {
+		return nil
+	}
+	return &models.Product{
+		ID:          p.Id,
+		Name:        p.Name,
+		Description: p.Description,
+		Price:       p.Price,
+		Category:    p.Category,
+		Images:      p.Images,
+		SellerID:    p.SellerId,
+		Available:   p.Available,
+		CreatedAt:   time.Unix(p.CreatedAtUnix, 0),
+		UpdatedAt:   time.Unix(p.UpdatedAtUnix, 0),
 	}
+}
 
-	switch st.Code() {
-	case codes.NotFound:
-		return ErrNotFound
-	case codes.PermissionDenied, codes.Unauthenticated:
-		return ErrUnauthorized
-	default:
-		return ErrInternal
+func inventoryFromProto(inv *inventorypb.Inventory) *models.Inventory {
+	if inv == nil {
+		return nil
+	}
+	return &models.Inventory{
+		ProductID: inv.ProductId,
+		Quantity:  inv.Quantity,
+		Reserved:  inv.Reserved,
+		Available: inv.Available,
+	}
+}
+
+func addressToProto(a models.Address) *userpb.Address {
+	return &userpb.Address{
+		Street:     a.Street,
+		City:       a.City,
+		State:      a.State,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+	}
+}
+
+func addressFromProto(a *userpb.Address) models.Address {
+	if a == nil {
+		return models.Address{}
+	}
+	return models.Address{
+		Street:     a.Street,
+		City:       a.City,
+		State:      a.State,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+	}
+}
+
+func orderItemFromProto(i *userpb.OrderItem) models.OrderItem {
+	return models.OrderItem{
+		ProductID:   i.ProductId,
+		ProductName: i.ProductName,
+		Quantity:    i.Quantity,
+		UnitPrice:   i.UnitPrice,
+		TotalPrice:  i.TotalPrice,
+	}
+}
+
+func orderFromProto(o *userpb.Order) *models.Order {
+	if o == nil {
+		return nil
+	}
+	items := make([]models.OrderItem, 0, len(o.Items))
+	for _, i := range o.Items {
+		items = append(items, orderItemFromProto(i))
+	}
+	return &models.Order{
+		ID:             o.Id,
+		UserID:         o.UserId,
+		Items:          items,
+		Status:         o.Status,
+		TotalAmount:    o.TotalAmount,
+		ShippingAddr:   addressFromProto(o.ShippingAddress),
+		ReservationIDs: o.ReservationIds,
+		CreatedAt:      time.Unix(o.CreatedAtUnix, 0),
+		UpdatedAt:      time.Unix(o.UpdatedAtUnix, 0),
 	}
 }
 
 // --- Listing Service Methods ---
 
-// ListProducts fetches products from the listing service
-func (c *Clients) ListProducts(ctx context.Context, page, limit int, category, search string) ([]*models.Product, int64, error) {
-	// TODO: Implement actual gRPC call when proto files are available
-	// For now, return mock data for development
-	products := []*models.Product{
-		{
-			ID:          "prod-001",
-			Name:        "Sample Product",
-			Description: "A sample product for testing",
-			Price:       29.99,
-			Category:    "electronics",
-			Available:   true,
-		},
+// ListProducts fetches products from the listing service. limit and offset
+// are the gateway's already-resolved page/offset and count/limit pair (see
+// ProductHandler.ListProducts), so the listing service only ever sees one
+// pagination scheme.
+func (c *Clients) ListProducts(ctx context.Context, f *models.ProductSearch, limit, offset int) ([]*models.Product, int64, error) {
+	if c.listingClient == nil {
+		return nil, 0, ErrInternal
+	}
+
+	resp, err := c.listingClient.ListProducts(ctx, &listingpb.ListProductsRequest{
+		Limit:       int32(limit),
+		Offset:      int32(offset),
+		Category:    f.Category,
+		Search:      f.Search,
+		SortColumn:  f.SortColumn,
+		SortOrder:   f.SortOrder,
+		MinPrice:    f.MinPrice,
+		MaxPrice:    f.MaxPrice,
+		Tags:        f.Tags,
+		InStockOnly: f.InStockOnly,
+	})
+	if err != nil {
+		return nil, 0, handleGRPCError(err)
+	}
+
+	products := make([]*models.Product, 0, len(resp.Products))
+	for _, p := range resp.Products {
+		products = append(products, productFromProto(p))
 	}
-	return products, 1, nil
+	return products, resp.Total, nil
 }
 
 // GetProduct fetches a single product from the listing service
 func (c *Clients) GetProduct(ctx context.Context, id string) (*models.Product, error) {
-	// TODO: Implement actual gRPC call
-	if id == "not-found" {
-		return nil, ErrNotFound
+	if c.listingClient == nil {
+		return nil, ErrInternal
 	}
-	return &models.Product{
-		ID:          id,
-		Name:        "Sample Product",
-		Description: "A sample product for testing",
-		Price:       29.99,
-		Category:    "electronics",
-		Available:   true,
-	}, nil
+
+	p, err := c.listingClient.GetProduct(ctx, &listingpb.GetProductRequest{Id: id})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+	return productFromProto(p), nil
 }
 
 // CreateProduct creates a new product via the listing service
 func (c *Clients) CreateProduct(ctx context.Context, req *models.CreateProductRequest, userID string) (*models.Product, error) {
-	// TODO: Implement actual gRPC call
-	return &models.Product{
-		ID:          "prod-new",
+	if c.listingClient == nil {
+		return nil, ErrInternal
+	}
+
+	p, err := c.listingClient.CreateProduct(ctx, &listingpb.CreateProductRequest{
 		Name:        req.Name,
 		Description: req.Description,
 		Price:       req.Price,
 		Category:    req.Category,
 		Images:      req.Images,
-		SellerID:    userID,
-		Available:   true,
-	}, nil
+		SellerId:    userID,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+	return productFromProto(p), nil
 }
 
 // UpdateProduct updates an existing product
 func (c *Clients) UpdateProduct(ctx context.Context, id string, req *models.UpdateProductRequest, userID string) (*models.Product, error) {
-	// TODO: Implement actual gRPC call
-	return &models.Product{
-		ID:       id,
-		SellerID: userID,
-	}, nil
+	if c.listingClient == nil {
+		return nil, ErrInternal
+	}
+
+	var images []string
+	if req.Images != nil {
+		images = *req.Images
+	}
+	p, err := c.listingClient.UpdateProduct(ctx, &listingpb.UpdateProductRequest{
+		Id:          id,
+		SellerId:    userID,
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		Category:    req.Category,
+		Images:      images,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+	return productFromProto(p), nil
 }
 
 // DeleteProduct deletes a product
 func (c *Clients) DeleteProduct(ctx context.Context, id, userID string) error {
-	// TODO: Implement actual gRPC call
-	return nil
+	if c.listingClient == nil {
+		return ErrInternal
+	}
+
+	_, err := c.listingClient.DeleteProduct(ctx, &listingpb.DeleteProductRequest{Id: id, SellerId: userID})
+	return handleGRPCError(err)
 }
 
 // --- Inventory Service Methods ---
 
 // GetInventory gets inventory for a product
 func (c *Clients) GetInventory(ctx context.Context, productID string) (*models.Inventory, error) {
-	// TODO: Implement actual gRPC call
-	return &models.Inventory{
-		ProductID: productID,
-		Quantity:  100,
-		Reserved:  5,
-		Available: true,
-	}, nil
+	if c.inventoryClient == nil {
+		return nil, ErrInternal
+	}
+
+	inv, err := c.inventoryClient.GetInventory(ctx, &inventorypb.GetInventoryRequest{ProductId: productID})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+	return inventoryFromProto(inv), nil
 }
 
 // InitializeInventory sets up initial inventory for a new product
 func (c *Clients) InitializeInventory(ctx context.Context, productID string, quantity int32) error {
-	// TODO: Implement actual gRPC call
-	return nil
+	if c.inventoryClient == nil {
+		return ErrInternal
+	}
+
+	_, err := c.inventoryClient.InitializeInventory(ctx, &inventorypb.InitializeInventoryRequest{
+		ProductId: productID,
+		Quantity:  quantity,
+	})
+	return handleGRPCError(err)
 }
 
 // UpdateInventory updates inventory quantity
 func (c *Clients) UpdateInventory(ctx context.Context, productID string, quantity int32, operation string) (*models.Inventory, error) {
-	// TODO: Implement actual gRPC call
-	return &models.Inventory{
-		ProductID: productID,
+	if c.inventoryClient == nil {
+		return nil, ErrInternal
+	}
+
+	inv, err := c.inventoryClient.UpdateInventory(ctx, &inventorypb.UpdateInventoryRequest{
+		ProductId: productID,
 		Quantity:  quantity,
-		Available: quantity > 0,
-	}, nil
+		Operation: operation,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+	return inventoryFromProto(inv), nil
 }
 
 // CheckInventory checks if requested quantity is available
 func (c *Clients) CheckInventory(ctx context.Context, productID string, quantity int32) (bool, error) {
-	// TODO: Implement actual gRPC call
-	return true, nil
+	if c.inventoryClient == nil {
+		return false, ErrInternal
+	}
+
+	resp, err := c.inventoryClient.CheckInventory(ctx, &inventorypb.CheckInventoryRequest{
+		ProductId: productID,
+		Quantity:  quantity,
+	})
+	if err != nil {
+		return false, handleGRPCError(err)
+	}
+	return resp.Available, nil
 }
 
 // ReserveInventory reserves inventory for an order
 func (c *Clients) ReserveInventory(ctx context.Context, productID string, quantity int32) (string, error) {
-	// TODO: Implement actual gRPC call
-	return "reservation-" + productID, nil
+	if c.inventoryClient == nil {
+		return "", ErrInternal
+	}
+
+	resp, err := c.inventoryClient.ReserveInventory(ctx, &inventorypb.ReserveInventoryRequest{
+		ProductId: productID,
+		Quantity:  quantity,
+	})
+	if err != nil {
+		return "", handleGRPCError(err)
+	}
+	return resp.ReservationId, nil
 }
 
 // CancelReservation cancels an inventory reservation
 func (c *Clients) CancelReservation(ctx context.Context, reservationID string) error {
-	// TODO: Implement actual gRPC call
-	return nil
+	if c.inventoryClient == nil {
+		return ErrInternal
+	}
+
+	_, err := c.inventoryClient.CancelReservation(ctx, &inventorypb.CancelReservationRequest{ReservationId: reservationID})
+	return handleGRPCError(err)
 }
 
 // --- User/Order Service Methods ---
 
 // ListOrders fetches orders for a user
 func (c *Clients) ListOrders(ctx context.Context, userID string, page, limit int, status string) ([]*models.Order, int64, error) {
-	// TODO: Implement actual gRPC call
-	return []*models.Order{}, 0, nil
+	if c.userClient == nil {
+		return nil, 0, ErrInternal
+	}
+
+	resp, err := c.userClient.ListOrders(ctx, &userpb.ListOrdersRequest{
+		UserId: userID,
+		Page:   int32(page),
+		Limit:  int32(limit),
+		Status: status,
+	})
+	if err != nil {
+		return nil, 0, handleGRPCError(err)
+	}
+
+	orders := make([]*models.Order, 0, len(resp.Orders))
+	for _, o := range resp.Orders {
+		orders = append(orders, orderFromProto(o))
+	}
+	return orders, resp.Total, nil
 }
 
 // GetOrder fetches a single order
 func (c *Clients) GetOrder(ctx context.Context, orderID, userID string) (*models.Order, error) {
-	// TODO: Implement actual gRPC call
-	if orderID == "not-found" {
-		return nil, ErrNotFound
+	if c.userClient == nil {
+		return nil, ErrInternal
 	}
-	return &models.Order{
-		ID:     orderID,
-		UserID: userID,
-		Status: "pending",
-	}, nil
+
+	o, err := c.userClient.GetOrder(ctx, &userpb.GetOrderRequest{OrderId: orderID, UserId: userID})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+	return orderFromProto(o), nil
 }
 
 // CreateOrder creates a new order
 func (c *Clients) CreateOrder(ctx context.Context, userID string, req *models.CreateOrderRequest, reservationIDs []string) (*models.Order, error) {
-	// TODO: Implement actual gRPC call
-	var items []models.OrderItem
-	var total float64
+	if c.userClient == nil {
+		return nil, ErrInternal
+	}
+
+	items := make([]*userpb.OrderItem, 0, len(req.Items))
 	for _, item := range req.Items {
-		orderItem := models.OrderItem{
-			ProductID:  item.ProductID,
-			Quantity:   item.Quantity,
-			UnitPrice:  29.99, // Would come from product lookup
-			TotalPrice: float64(item.Quantity) * 29.99,
-		}
-		items = append(items, orderItem)
-		total += orderItem.TotalPrice
+		items = append(items, &userpb.OrderItem{
+			ProductId: item.ProductID,
+			Quantity:  item.Quantity,
+		})
 	}
 
-	return &models.Order{
-		ID:             "order-new",
-		UserID:         userID,
-		Items:          items,
-		Status:         "pending",
-		TotalAmount:    total,
-		ShippingAddr:   req.ShippingAddr,
-		ReservationIDs: reservationIDs,
-	}, nil
+	o, err := c.userClient.CreateOrder(ctx, &userpb.CreateOrderRequest{
+		UserId:          userID,
+		Items:           items,
+		ShippingAddress: addressToProto(req.ShippingAddr),
+		ReservationIds:  reservationIDs,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+	return orderFromProto(o), nil
 }
 
 // UpdateOrderStatus updates the status of an order
 func (c *Clients) UpdateOrderStatus(ctx context.Context, orderID, userID, status string) (*models.Order, error) {
-	// TODO: Implement actual gRPC call
-	return &models.Order{
-		ID:     orderID,
-		UserID: userID,
-		Status: status,
-	}, nil
+	if c.userClient == nil {
+		return nil, ErrInternal
+	}
+
+	o, err := c.userClient.UpdateOrderStatus(ctx, &userpb.UpdateOrderStatusRequest{
+		OrderId: orderID,
+		UserId:  userID,
+		Status:  status,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+	return orderFromProto(o), nil
 }
 
 // CancelOrder cancels an order
 func (c *Clients) CancelOrder(ctx context.Context, orderID, userID string) error {
-	// TODO: Implement actual gRPC call
-	return nil
+	if c.userClient == nil {
+		return ErrInternal
+	}
+
+	_, err := c.userClient.CancelOrder(ctx, &userpb.CancelOrderRequest{OrderId: orderID, UserId: userID})
+	return handleGRPCError(err)
 }
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ecommerce/be-api-gin/internal/apierr"
+	"github.com/ecommerce/be-api-gin/proto/gen/errdetailpb"
+)
+
+// businessError reads an errdetailpb.BusinessError off st's details, if
+// upstream attached one. Unlike sniffing the status message for a "CODE:
+// message" prefix, a detail is a typed field on the status proto: it can't
+// be spoofed by an unrelated message that happens to look like one, and it
+// survives the message being rewritten for display.
+func businessError(st *status.Status) (*errdetailpb.BusinessError, bool) {
+	for _, detail := range st.Proto().GetDetails() {
+		var be errdetailpb.BusinessError
+		if detail.MessageIs(&be) {
+			if err := detail.UnmarshalTo(&be); err == nil {
+				return &be, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// TranslateError unwraps a gRPC call error into the apierr.Code the gateway
+// renders to clients. It first looks for a registered business code in the
+// status's details, then falls back to a generic mapping from the gRPC
+// status code.
+func TranslateError(err error) *apierr.Error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return apierr.New(apierr.CodeInternal, err)
+	}
+
+	if be, ok := businessError(st); ok {
+		code := apierr.Code(be.Code)
+		if _, known := apierr.Lookup(code); known {
+			return apierr.New(code, errors.New(be.Message))
+		}
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return apierr.New(apierr.CodeNotFound, err)
+	case codes.Unauthenticated:
+		return apierr.New(apierr.CodeUnauthenticated, err)
+	case codes.PermissionDenied:
+		return apierr.New(apierr.CodeUnauthorized, err)
+	case codes.InvalidArgument:
+		return apierr.New(apierr.CodeInvalidArgument, err)
+	case codes.FailedPrecondition:
+		return apierr.New(apierr.CodeFailedPrecondition, err)
+	case codes.ResourceExhausted:
+		return apierr.New(apierr.CodeResourceExhausted, err)
+	default:
+		return apierr.New(apierr.CodeInternal, err)
+	}
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ecommerce/be-api-gin/internal/apierr"
+	"github.com/ecommerce/be-api-gin/proto/gen/errdetailpb"
+)
+
+// buildUpstreamStatusError mirrors how a genuine upstream service attaches a
+// BusinessError detail: a real message of the registered errdetailpb type,
+// packed into an anypb.Any the same way status.WithDetails does for any
+// protoc-gen-go message, with no shortcuts specific to this test.
+func buildUpstreamStatusError(t *testing.T, code codes.Code, businessCode, message string) error {
+	t.Helper()
+	st, err := status.New(code, message).WithDetails(&errdetailpb.BusinessError{
+		Code:    businessCode,
+		Message: message,
+	})
+	if err != nil {
+		t.Fatalf("attach detail: %v", err)
+	}
+	return st.Err()
+}
+
+func TestTranslateErrorExtractsBusinessCodeFromAnyDetail(t *testing.T) {
+	err := buildUpstreamStatusError(t, codes.FailedPrecondition, "INVENTORY_INSUFFICIENT", "not enough stock")
+
+	got := TranslateError(err)
+	if got.Code != apierr.CodeInventoryInsufficient {
+		t.Fatalf("code = %s, want %s", got.Code, apierr.CodeInventoryInsufficient)
+	}
+	if got.Cause == nil || got.Cause.Error() != "not enough stock" {
+		t.Fatalf("cause = %v, want the business message", got.Cause)
+	}
+}
+
+func TestTranslateErrorFallsBackToGRPCCodeWhenDetailIsUnrecognized(t *testing.T) {
+	// A registered detail whose code isn't in the apierr catalog must not be
+	// trusted blindly; fall back to the generic gRPC-code mapping.
+	err := buildUpstreamStatusError(t, codes.FailedPrecondition, "SOME_UNKNOWN_CODE", "whatever")
+
+	got := TranslateError(err)
+	if got.Code != apierr.CodeFailedPrecondition {
+		t.Fatalf("code = %s, want %s", got.Code, apierr.CodeFailedPrecondition)
+	}
+}
+
+func TestTranslateErrorFallsBackToGRPCCodeWithoutAnyDetail(t *testing.T) {
+	err := status.New(codes.NotFound, "no such product").Err()
+
+	got := TranslateError(err)
+	if got.Code != apierr.CodeNotFound {
+		t.Fatalf("code = %s, want %s", got.Code, apierr.CodeNotFound)
+	}
+}
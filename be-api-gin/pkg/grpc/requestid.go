@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDMetadataKey is the outbound gRPC metadata key the request ID is
+// attached under, mirroring the X-Request-ID HTTP header used by the
+// gateway.
+const RequestIDMetadataKey = "x-request-id"
+
+type contextKey int
+
+const requestIDContextKey contextKey = iota
+
+// ContextWithRequestID returns a copy of ctx carrying requestID, so that a
+// single ID generated at the edge of the gateway flows through to every
+// downstream gRPC call made with that context.
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey, requestID)
+}
+
+// RequestIDFromContext returns the request ID stored by ContextWithRequestID,
+// if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDContextKey).(string)
+	return requestID, ok
+}
+
+// requestIDUnaryClientInterceptor propagates the request ID carried on ctx
+// (see ContextWithRequestID) onto outbound gRPC calls as x-request-id
+// metadata, so a single ID can be traced across the user/listing/inventory
+// services for a given gateway request.
+func requestIDUnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if requestID, ok := RequestIDFromContext(ctx); ok && requestID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, RequestIDMetadataKey, requestID)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
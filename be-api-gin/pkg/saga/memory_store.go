@@ -0,0 +1,73 @@
+package saga
+
+import (
+	"sync"
+	"time"
+)
+
+// MemoryStore is a process-local Store: fine for tests and for a
+// single-replica deployment, but a crash loses every in-flight saga along
+// with the process, so NewReaper has nothing left to claim. Use RedisStore
+// wherever that matters.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+// NewMemoryStore builds an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]Record)}
+}
+
+// Create implements Store.
+func (s *MemoryStore) Create(rec Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.records[rec.ID]; exists {
+		return ErrRecordExists
+	}
+	s.records[rec.ID] = rec
+	return nil
+}
+
+// Update implements Store.
+func (s *MemoryStore) Update(rec Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[rec.ID] = rec
+	return nil
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(id string) (Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.records[id]
+	if !ok {
+		return Record{}, ErrNotFound
+	}
+	return rec, nil
+}
+
+// ClaimAbandoned implements Store.
+func (s *MemoryStore) ClaimAbandoned(owner string, leaseFor time.Duration) ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var claimed []Record
+	for id, rec := range s.records {
+		if rec.Status != StatusRunning && rec.Status != StatusCompensating {
+			continue
+		}
+		if rec.LeaseExpiresAt.After(now) {
+			continue
+		}
+		rec.LeaseOwner = owner
+		rec.LeaseExpiresAt = now.Add(leaseFor)
+		rec.UpdatedAt = now
+		s.records[id] = rec
+		claimed = append(claimed, rec)
+	}
+	return claimed, nil
+}
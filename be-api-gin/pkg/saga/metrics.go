@@ -0,0 +1,14 @@
+package saga
+
+import "expvar"
+
+// stepOutcomes counts how each named step has resolved, e.g.
+// "reserve-inventory:done" or "create-order:compensated", so an operator
+// can tell which step is actually failing from /debug/vars without
+// grepping logs. It is process-local like the rest of expvar; the durable
+// Record in Store remains the source of truth for any one saga.
+var stepOutcomes = expvar.NewMap("saga_step_outcomes_total")
+
+func recordStepOutcome(step string, status StepStatus) {
+	stepOutcomes.Add(step+":"+string(status), 1)
+}
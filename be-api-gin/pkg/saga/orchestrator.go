@@ -0,0 +1,196 @@
+package saga
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultLease is how long a saga's lease is held before a Reaper will
+// consider its owner dead and reclaim it.
+const DefaultLease = 30 * time.Second
+
+// Orchestrator runs saga Definitions, persisting progress to a Store after
+// every step so a Reaper can pick up where a crashed owner left off.
+type Orchestrator struct {
+	store       Store
+	definitions map[string]Definition
+}
+
+// NewOrchestrator builds an Orchestrator backed by store.
+func NewOrchestrator(store Store) *Orchestrator {
+	return &Orchestrator{store: store, definitions: make(map[string]Definition)}
+}
+
+// Register adds a Definition the Orchestrator (and any Reaper sharing its
+// Store) can run. Definitions are registered once at startup, not built
+// per-request, so a Record's Kind alone is enough to resolve its Steps
+// after a restart.
+func (o *Orchestrator) Register(def Definition) {
+	o.definitions[def.Kind] = def
+}
+
+// Start creates a new Record for kind and runs it to completion or failure.
+func (o *Orchestrator) Start(ctx context.Context, kind, id, userID string, data map[string]string) (Record, error) {
+	def, ok := o.definitions[kind]
+	if !ok {
+		return Record{}, fmt.Errorf("saga: no definition registered for kind %q", kind)
+	}
+
+	steps := make([]StepRecord, len(def.Steps))
+	for i, step := range def.Steps {
+		steps[i] = StepRecord{Name: step.Name, Status: StepPending}
+	}
+
+	rec := Record{
+		ID:             id,
+		Kind:           kind,
+		UserID:         userID,
+		Status:         StatusRunning,
+		Data:           data,
+		Steps:          steps,
+		LeaseOwner:     "inline",
+		LeaseExpiresAt: time.Now().Add(DefaultLease),
+		UpdatedAt:      time.Now(),
+	}
+	if err := o.store.Create(rec); err != nil {
+		return rec, err
+	}
+
+	return o.run(ctx, def, rec)
+}
+
+// Get returns the persisted Record for id, for status/debugging endpoints.
+func (o *Orchestrator) Get(id string) (Record, error) {
+	return o.store.Get(id)
+}
+
+// Resume continues a Record a Reaper has claimed: if it is still Running it
+// picks up forward execution at the first pending step; if it is
+// Compensating it continues unwinding from the last step that isn't
+// already compensated.
+func (o *Orchestrator) Resume(ctx context.Context, rec Record) (Record, error) {
+	def, ok := o.definitions[rec.Kind]
+	if !ok {
+		return rec, fmt.Errorf("saga: no definition registered for kind %q", rec.Kind)
+	}
+	return o.run(ctx, def, rec)
+}
+
+func (o *Orchestrator) run(ctx context.Context, def Definition, rec Record) (Record, error) {
+	run := &Run{ID: rec.ID, UserID: rec.UserID, Data: rec.Data}
+
+	failedAt := -1
+	var cause error
+	if rec.Status == StatusCompensating {
+		// A prior owner already picked the failing step; resuming means
+		// continuing the unwind, not re-running forward steps. The cause
+		// that triggered compensation died with that owner, so callers
+		// resuming this way only see the generic failedAt error below.
+		failedAt = len(def.Steps)
+		for i, s := range rec.Steps {
+			if s.Status == StepFailed {
+				failedAt = i
+				break
+			}
+		}
+	} else {
+		for i, step := range def.Steps {
+			if rec.Steps[i].Status == StepDone {
+				continue // already completed by a prior owner before it died
+			}
+
+			stepCtx := ctx
+			var cancel context.CancelFunc
+			if step.Timeout > 0 {
+				stepCtx, cancel = context.WithTimeout(ctx, step.Timeout)
+			}
+			err := step.Do(stepCtx, run)
+			if cancel != nil {
+				cancel()
+			}
+
+			rec.Data = run.Data
+			if err != nil {
+				rec.Steps[i].Status = StepFailed
+				recordStepOutcome(step.Name, StepFailed)
+				failedAt = i
+				cause = err
+				o.persist(rec)
+				break
+			}
+			rec.Steps[i].Status = StepDone
+			recordStepOutcome(step.Name, StepDone)
+			o.persist(rec)
+		}
+	}
+
+	if failedAt == -1 {
+		rec.Status = StatusCompleted
+		o.persist(rec)
+		return rec, nil
+	}
+
+	rec.Status = StatusCompensating
+	o.persist(rec)
+
+	for i := failedAt - 1; i >= 0; i-- {
+		step := def.Steps[i]
+		if rec.Steps[i].Status == StepCompensated || step.Compensate == nil {
+			continue
+		}
+
+		stepCtx := ctx
+		var cancel context.CancelFunc
+		if step.Timeout > 0 {
+			stepCtx, cancel = context.WithTimeout(ctx, step.Timeout)
+		}
+		err := step.Compensate(stepCtx, run)
+		if cancel != nil {
+			cancel()
+		}
+
+		rec.Data = run.Data
+		if err != nil {
+			// Compensation failures are recorded but do not stop the
+			// unwind: every remaining step still gets a chance to roll
+			// back its own state. The step is marked StepFailed and the
+			// record ends Failed (not Completed); ClaimAbandoned does not
+			// return Failed records, so the reaper does not retry it.
+			rec.Steps[i].Status = StepFailed
+			recordStepOutcome(step.Name, StepFailed)
+		} else {
+			rec.Steps[i].Status = StepCompensated
+			recordStepOutcome(step.Name, StepCompensated)
+		}
+		o.persist(rec)
+	}
+
+	rec.Status = StatusFailed
+	o.persist(rec)
+	if cause != nil {
+		// Preserve the failing step's own error (which may be a typed
+		// *apierr.Error from a gRPC call) so a caller can render it with
+		// its original code instead of a generic saga-failed message.
+		return rec, cause
+	}
+	return rec, fmt.Errorf("saga %s: %s", rec.ID, firstFailure(rec))
+}
+
+func firstFailure(rec Record) string {
+	for _, s := range rec.Steps {
+		if s.Status == StepFailed {
+			return s.Name + " failed"
+		}
+	}
+	return "step failed"
+}
+
+// persist writes rec back to the Store, renewing its lease. Persist errors
+// are not fatal to the in-flight run: the reaper will simply re-claim and
+// retry this saga from its last successfully persisted state.
+func (o *Orchestrator) persist(rec Record) {
+	rec.UpdatedAt = time.Now()
+	rec.LeaseExpiresAt = time.Now().Add(DefaultLease)
+	_ = o.store.Update(rec)
+}
@@ -0,0 +1,174 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// recordingSteps returns three steps and the slices recorded into as they
+// run, so a test can assert both what ran and in what order.
+func recordingSteps(failAt int, compensateErrAt int) ([]Step, *[]string, *[]string) {
+	var done []string
+	var compensated []string
+
+	steps := make([]Step, 3)
+	for i := 0; i < 3; i++ {
+		i := i
+		steps[i] = Step{
+			Name: namesFor(i),
+			Do: func(ctx context.Context, run *Run) error {
+				if i == failAt {
+					return errors.New("boom")
+				}
+				done = append(done, namesFor(i))
+				return nil
+			},
+			Compensate: func(ctx context.Context, run *Run) error {
+				if i == compensateErrAt {
+					return errors.New("compensate failed")
+				}
+				compensated = append(compensated, namesFor(i))
+				return nil
+			},
+		}
+	}
+	return steps, &done, &compensated
+}
+
+func namesFor(i int) string {
+	return []string{"step-0", "step-1", "step-2"}[i]
+}
+
+func TestOrchestratorCompensatesCompletedStepsInReverseOrder(t *testing.T) {
+	steps, done, compensated := recordingSteps(2, -1)
+	o := NewOrchestrator(NewMemoryStore())
+	o.Register(Definition{Kind: "test", Steps: steps})
+
+	rec, err := o.Start(context.Background(), "test", "saga-1", "user-1", nil)
+	if err == nil {
+		t.Fatal("expected the failing third step to surface an error")
+	}
+	if rec.Status != StatusFailed {
+		t.Fatalf("status = %s, want %s", rec.Status, StatusFailed)
+	}
+
+	if got, want := *done, []string{"step-0", "step-1"}; !equalStrings(got, want) {
+		t.Fatalf("steps run = %v, want %v", got, want)
+	}
+	// step-2 (the failing step) never completed, so only step-1 then
+	// step-0 should have been compensated, in that reverse order.
+	if got, want := *compensated, []string{"step-1", "step-0"}; !equalStrings(got, want) {
+		t.Fatalf("steps compensated = %v, want %v (reverse completion order)", got, want)
+	}
+}
+
+func TestOrchestratorContinuesUnwindAfterACompensateFails(t *testing.T) {
+	// step-1's Compensate fails; step-0 must still get its chance to
+	// compensate instead of the unwind stopping partway through.
+	steps, _, compensated := recordingSteps(2, 1)
+	o := NewOrchestrator(NewMemoryStore())
+	o.Register(Definition{Kind: "test", Steps: steps})
+
+	rec, err := o.Start(context.Background(), "test", "saga-1", "user-1", nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if rec.Status != StatusFailed {
+		t.Fatalf("status = %s, want %s", rec.Status, StatusFailed)
+	}
+	if rec.Steps[1].Status != StepFailed {
+		t.Fatalf("step-1 status = %s, want %s (its own Compensate failed)", rec.Steps[1].Status, StepFailed)
+	}
+	if got, want := *compensated, []string{"step-0"}; !equalStrings(got, want) {
+		t.Fatalf("steps compensated = %v, want %v", got, want)
+	}
+}
+
+func TestOrchestratorResumeContinuesForwardWhenRunning(t *testing.T) {
+	store := NewMemoryStore()
+	var secondRan bool
+	o := NewOrchestrator(store)
+	o.Register(Definition{Kind: "test", Steps: []Step{
+		{Name: "step-0", Do: func(ctx context.Context, run *Run) error { return nil }},
+		{Name: "step-1", Do: func(ctx context.Context, run *Run) error { secondRan = true; return nil }},
+	}})
+
+	// Simulate a record left behind by a crashed owner: step-0 already
+	// done, step-1 still pending.
+	rec := Record{
+		ID:     "saga-1",
+		Kind:   "test",
+		Status: StatusRunning,
+		Steps: []StepRecord{
+			{Name: "step-0", Status: StepDone},
+			{Name: "step-1", Status: StepPending},
+		},
+	}
+	if err := store.Create(rec); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	resumed, err := o.Resume(context.Background(), rec)
+	if err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	if !secondRan {
+		t.Fatal("Resume on a Running record should pick up the first pending step")
+	}
+	if resumed.Status != StatusCompleted {
+		t.Fatalf("status = %s, want %s", resumed.Status, StatusCompleted)
+	}
+}
+
+func TestOrchestratorResumeContinuesUnwindWhenCompensating(t *testing.T) {
+	store := NewMemoryStore()
+	var step0Compensated bool
+	o := NewOrchestrator(store)
+	o.Register(Definition{Kind: "test", Steps: []Step{
+		{
+			Name:       "step-0",
+			Do:         func(ctx context.Context, run *Run) error { return nil },
+			Compensate: func(ctx context.Context, run *Run) error { step0Compensated = true; return nil },
+		},
+		{Name: "step-1", Do: func(ctx context.Context, run *Run) error { return errors.New("boom") }},
+	}})
+
+	// Left mid-unwind by a crashed owner: step-1 is the failed step,
+	// step-0 is done but not yet compensated.
+	rec := Record{
+		ID:     "saga-1",
+		Kind:   "test",
+		Status: StatusCompensating,
+		Steps: []StepRecord{
+			{Name: "step-0", Status: StepDone},
+			{Name: "step-1", Status: StepFailed},
+		},
+	}
+	if err := store.Create(rec); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	resumed, err := o.Resume(context.Background(), rec)
+	if err == nil {
+		t.Fatal("expected Resume to report the saga as failed")
+	}
+	if !step0Compensated {
+		t.Fatal("Resume on a Compensating record should finish unwinding the still-done steps")
+	}
+	if resumed.Status != StatusFailed {
+		t.Fatalf("status = %s, want %s", resumed.Status, StatusFailed)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
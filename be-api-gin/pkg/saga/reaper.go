@@ -0,0 +1,60 @@
+package saga
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Reaper periodically reclaims sagas whose owning process died mid-flight
+// (its lease expired without the record reaching a terminal status) and
+// resumes or compensates them, so a gateway crash between two steps cannot
+// leave, say, an inventory reservation orphaned forever.
+type Reaper struct {
+	orchestrator *Orchestrator
+	store        Store
+	ownerID      string
+	interval     time.Duration
+	leaseFor     time.Duration
+}
+
+// NewReaper builds a Reaper that polls store every interval for sagas whose
+// lease has expired, identifying itself as ownerID while it works on them.
+func NewReaper(orchestrator *Orchestrator, store Store, ownerID string, interval time.Duration) *Reaper {
+	return &Reaper{
+		orchestrator: orchestrator,
+		store:        store,
+		ownerID:      ownerID,
+		interval:     interval,
+		leaseFor:     DefaultLease,
+	}
+}
+
+// Run polls until ctx is cancelled. Call it in its own goroutine.
+func (r *Reaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.reapOnce(ctx)
+		}
+	}
+}
+
+func (r *Reaper) reapOnce(ctx context.Context) {
+	abandoned, err := r.store.ClaimAbandoned(r.ownerID, r.leaseFor)
+	if err != nil {
+		log.Printf("saga reaper: failed to claim abandoned sagas: %v", err)
+		return
+	}
+
+	for _, rec := range abandoned {
+		if _, err := r.orchestrator.Resume(ctx, rec); err != nil {
+			log.Printf("saga reaper: resuming saga %s (%s): %v", rec.ID, rec.Kind, err)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package saga
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestReapOnceResumesBothRunningAndCompensatingSagas(t *testing.T) {
+	store := NewMemoryStore()
+	var runningResumed, compensatingResumed bool
+
+	o := NewOrchestrator(store)
+	o.Register(Definition{Kind: "test", Steps: []Step{
+		{Name: "step-0", Do: func(ctx context.Context, run *Run) error { return nil }},
+	}})
+
+	past := time.Now().Add(-time.Minute)
+	running := Record{
+		ID: "saga-running", Kind: "test", Status: StatusRunning,
+		Steps:          []StepRecord{{Name: "step-0", Status: StepPending}},
+		LeaseExpiresAt: past,
+	}
+	compensating := Record{
+		ID: "saga-compensating", Kind: "test", Status: StatusCompensating,
+		Steps:          []StepRecord{{Name: "step-0", Status: StepDone}},
+		LeaseExpiresAt: past,
+	}
+	done := Record{
+		ID: "saga-done", Kind: "test", Status: StatusCompleted,
+		Steps:          []StepRecord{{Name: "step-0", Status: StepDone}},
+		LeaseExpiresAt: past,
+	}
+	for _, rec := range []Record{running, compensating, done} {
+		if err := store.Create(rec); err != nil {
+			t.Fatalf("create %s: %v", rec.ID, err)
+		}
+	}
+
+	// Wrap Resume via the orchestrator by checking post-state, since the
+	// Reaper only calls orchestrator.Resume/Store.ClaimAbandoned - observe
+	// through the persisted records' status after reaping.
+	r := NewReaper(o, store, "test-owner", time.Hour)
+	r.reapOnce(context.Background())
+
+	got, err := store.Get("saga-running")
+	if err != nil {
+		t.Fatalf("get saga-running: %v", err)
+	}
+	if got.Status != StatusCompleted {
+		t.Fatalf("running saga status after reap = %s, want %s", got.Status, StatusCompleted)
+	}
+	runningResumed = got.Status == StatusCompleted
+
+	got, err = store.Get("saga-compensating")
+	if err != nil {
+		t.Fatalf("get saga-compensating: %v", err)
+	}
+	// step-0 has no Compensate func, so nothing to unwind; its only
+	// pending step's absence means it completes the (empty) unwind.
+	if got.Status != StatusFailed {
+		t.Fatalf("compensating saga status after reap = %s, want %s", got.Status, StatusFailed)
+	}
+	compensatingResumed = got.Status == StatusFailed
+
+	if !runningResumed || !compensatingResumed {
+		t.Fatal("reapOnce should have claimed and resumed both non-terminal sagas")
+	}
+
+	// The already-terminal saga must not have been touched: ClaimAbandoned
+	// only returns StatusRunning/StatusCompensating records.
+	got, err = store.Get("saga-done")
+	if err != nil {
+		t.Fatalf("get saga-done: %v", err)
+	}
+	if got.LeaseOwner == "test-owner" {
+		t.Fatal("reapOnce must not claim a saga that already reached a terminal status")
+	}
+}
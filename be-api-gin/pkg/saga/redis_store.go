@@ -0,0 +1,151 @@
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// activeSagasKey is a sorted set of in-flight saga IDs scored by their lease
+// expiry, so ClaimAbandoned can find candidates with a single ZRANGEBYSCORE
+// instead of scanning every record.
+const activeSagasKey = "sagas:active"
+
+// RedisStore is the durable Store used in production: records survive a
+// gateway restart, and are visible to whichever replica's Reaper wakes up
+// first.
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore builds a RedisStore against addr (host:port).
+func NewRedisStore(addr string) *RedisStore {
+	return &RedisStore{client: redis.NewClient(&redis.Options{Addr: addr})}
+}
+
+func recordKey(id string) string {
+	return "saga:" + id
+}
+
+// Create implements Store.
+func (s *RedisStore) Create(rec Record) error {
+	ctx := context.Background()
+	payload, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	ok, err := s.client.SetNX(ctx, recordKey(rec.ID), payload, 0).Result()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrRecordExists
+	}
+	return s.trackActive(ctx, rec)
+}
+
+// Update implements Store.
+func (s *RedisStore) Update(rec Record) error {
+	ctx := context.Background()
+	payload, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	if err := s.client.Set(ctx, recordKey(rec.ID), payload, 0).Err(); err != nil {
+		return err
+	}
+	return s.trackActive(ctx, rec)
+}
+
+// trackActive keeps activeSagasKey in sync with rec's terminal-ness: a
+// finished saga is removed so the reaper never has to look at it again.
+func (s *RedisStore) trackActive(ctx context.Context, rec Record) error {
+	if rec.Status == StatusCompleted || rec.Status == StatusFailed {
+		return s.client.ZRem(ctx, activeSagasKey, rec.ID).Err()
+	}
+	return s.client.ZAdd(ctx, activeSagasKey, redis.Z{
+		Score:  float64(rec.LeaseExpiresAt.Unix()),
+		Member: rec.ID,
+	}).Err()
+}
+
+// Get implements Store.
+func (s *RedisStore) Get(id string) (Record, error) {
+	raw, err := s.client.Get(context.Background(), recordKey(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return Record{}, ErrNotFound
+	}
+	if err != nil {
+		return Record{}, err
+	}
+	var rec Record
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return Record{}, err
+	}
+	return rec, nil
+}
+
+// claimAbandonedScript atomically finds every member of the activeSagasKey
+// ZSET whose score (lease expiry, as a unix timestamp) is not after "now",
+// and bumps its score to "newscore" in the same step. Redis runs the whole
+// script single-threaded, so two replicas racing this at the same moment
+// can never both see the same member still scored <= now: whichever one
+// the script body executes first re-scores it past the cutoff before the
+// other has a chance to read it, so only one replica's call ever returns a
+// given ID. That ID-level exclusivity is what makes the later Get+Update
+// (which only the winner performs) safe without locking the record itself.
+const claimAbandonedScript = `
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1])
+local claimed = {}
+for _, id in ipairs(ids) do
+  local score = redis.call('ZSCORE', KEYS[1], id)
+  if score and tonumber(score) <= tonumber(ARGV[1]) then
+    redis.call('ZADD', KEYS[1], ARGV[2], id)
+    table.insert(claimed, id)
+  end
+end
+return claimed
+`
+
+// ClaimAbandoned implements Store.
+func (s *RedisStore) ClaimAbandoned(owner string, leaseFor time.Duration) ([]Record, error) {
+	ctx := context.Background()
+	now := time.Now()
+
+	ids, err := s.client.Eval(ctx, claimAbandonedScript, []string{activeSagasKey},
+		strconv.FormatInt(now.Unix(), 10),
+		strconv.FormatInt(now.Add(leaseFor).Unix(), 10),
+	).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	var claimed []Record
+	for _, id := range ids {
+		rec, err := s.Get(id)
+		if errors.Is(err, ErrNotFound) {
+			// The record expired or was deleted out from under the index;
+			// drop the stale index entry and move on. The ZSET score was
+			// already bumped by the script above, so no other replica will
+			// retry this ID until the new lease window also elapses.
+			s.client.ZRem(ctx, activeSagasKey, id)
+			continue
+		}
+		if err != nil {
+			return claimed, err
+		}
+
+		rec.LeaseOwner = owner
+		rec.LeaseExpiresAt = now.Add(leaseFor)
+		rec.UpdatedAt = now
+		if err := s.Update(rec); err != nil {
+			return claimed, err
+		}
+		claimed = append(claimed, rec)
+	}
+	return claimed, nil
+}
@@ -0,0 +1,62 @@
+// Package saga implements durable saga-style distributed transactions: an
+// ordered list of steps, each with a compensating action, whose progress is
+// persisted to a Store after every step. A saga started here can be resumed
+// or unwound by a Reaper running in a different process than the one that
+// started it, which is what makes it safe to use for an operation like
+// order placement that must never leak a reservation because the gateway
+// happened to crash between two steps.
+package saga
+
+import (
+	"context"
+	"time"
+)
+
+// Run is the mutable, serializable context threaded through every step of
+// a saga. Steps communicate through Data instead of Go closures so a
+// Reaper in a fresh process can replay Compensate funcs from the persisted
+// record alone, without the original request's in-memory state.
+type Run struct {
+	ID     string
+	UserID string
+	Data   map[string]string
+}
+
+// Get returns Data[key], or "" if unset.
+func (r *Run) Get(key string) string {
+	return r.Data[key]
+}
+
+// Set stores value under key for later steps, Compensate funcs, and the
+// reaper to read back after a crash.
+func (r *Run) Set(key, value string) {
+	if r.Data == nil {
+		r.Data = make(map[string]string)
+	}
+	r.Data[key] = value
+}
+
+// Step is one unit of forward progress in a saga, plus how to undo it.
+type Step struct {
+	Name string
+
+	// Do performs the step, reading and writing run.Data as needed.
+	Do func(ctx context.Context, run *Run) error
+
+	// Compensate undoes Do. A nil Compensate means the step has nothing to
+	// undo (e.g. a read-only availability check).
+	Compensate func(ctx context.Context, run *Run) error
+
+	// Timeout bounds how long Do or Compensate may run for this step. Zero
+	// means no per-step timeout.
+	Timeout time.Duration
+}
+
+// Definition is a named, ordered list of steps. It is registered once with
+// an Orchestrator at startup (not built per-request), so the Reaper can
+// resolve the step list for any persisted Record by its Kind, even one
+// started by a process that has since died.
+type Definition struct {
+	Kind  string
+	Steps []Step
+}
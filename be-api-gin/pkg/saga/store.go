@@ -0,0 +1,77 @@
+package saga
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNotFound is returned by Store.Get when no record exists for an ID.
+var ErrNotFound = errors.New("saga: record not found")
+
+// ErrRecordExists is returned by Store.Create when the ID is already taken.
+var ErrRecordExists = errors.New("saga: record already exists")
+
+// StepStatus is the lifecycle state of a single step within a Record.
+type StepStatus string
+
+const (
+	StepPending      StepStatus = "pending"
+	StepDone         StepStatus = "done"
+	StepCompensated  StepStatus = "compensated"
+	StepCompensating StepStatus = "compensating"
+	StepFailed       StepStatus = "failed"
+)
+
+// Status is the lifecycle state of an entire saga run.
+type Status string
+
+const (
+	StatusRunning      Status = "running"
+	StatusCompleted    Status = "completed"
+	StatusCompensating Status = "compensating"
+	StatusFailed       Status = "failed"
+)
+
+// StepRecord is the persisted status of one step in a Record.
+type StepRecord struct {
+	Name   string     `json:"name"`
+	Status StepStatus `json:"status"`
+}
+
+// Record is the durable representation of one saga run: everything a Reaper
+// needs to resume or compensate it without the original request.
+type Record struct {
+	ID     string            `json:"id"`
+	Kind   string            `json:"kind"`
+	UserID string            `json:"user_id"`
+	Status Status            `json:"status"`
+	Data   map[string]string `json:"data"`
+	Steps  []StepRecord      `json:"steps"`
+
+	// LeaseOwner and LeaseExpiresAt let exactly one Reaper act on a given
+	// Record at a time: a Reaper only claims records whose lease has
+	// expired, and re-leases them to itself for the duration of its work.
+	LeaseOwner     string    `json:"lease_owner"`
+	LeaseExpiresAt time.Time `json:"lease_expires_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// Store persists saga Records keyed by ID. Implementations must make
+// ClaimAbandoned safe for concurrent Reapers: only one caller racing on the
+// same record may ever get it back.
+type Store interface {
+	// Create inserts a new Record. It returns an error if id already exists.
+	Create(rec Record) error
+
+	// Update overwrites the Record for rec.ID, which must already exist.
+	Update(rec Record) error
+
+	// Get returns the Record for id, or ErrNotFound.
+	Get(id string) (Record, error)
+
+	// ClaimAbandoned returns every Record still in StatusRunning or
+	// StatusCompensating whose lease has expired, immediately re-leasing
+	// each to owner for leaseFor so a concurrent Reaper does not also pick
+	// it up.
+	ClaimAbandoned(owner string, leaseFor time.Duration) ([]Record, error)
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"time"
 )
 
 // Config holds all configuration for the application
@@ -15,30 +16,94 @@ type Config struct {
 	JWTSecret     string
 	JWTExpiration int // in hours
 
+	// JWTKeyMode selects how AuthMiddleware resolves verification keys:
+	// "hmac" (default, static JWTSecret), "jwks" (fetch from JWKSURL and
+	// rotate), or "rsa-local" (this service's own RSA keypair).
+	JWTKeyMode          string
+	JWKSURL             string
+	JWKSRefreshInterval time.Duration
+	RSAPrivateKeyPath   string
+
+	// AdminRoles lists the roles accepted by AdminMiddleware, enabling
+	// multi-tenant RBAC instead of a single hardcoded "admin" role.
+	AdminRoles []string
+
 	// gRPC service addresses
 	UserServiceAddr      string
 	ListingServiceAddr   string
 	InventoryServiceAddr string
 
+	// gRPC dialing settings
+	GRPCMaxRetries        int           // max retries per unary call before giving up
+	GRPCInitialBackoff    time.Duration // starting backoff between reconnect/retry attempts
+	GRPCMaxBackoff        time.Duration // backoff ceiling
+	GRPCKeepaliveInterval time.Duration // ping interval for idle connections
+
+	// Service discovery settings
+	RegistryDriver string   // "static" (default), "consul", or "etcd"
+	ConsulAddr     string   // base URL of the Consul HTTP API, e.g. http://localhost:8500
+	EtcdEndpoints  []string // etcd cluster endpoints
+
 	// CORS settings
 	AllowedOrigins []string
 
 	// Rate limiting
 	RateLimit int // requests per second
+
+	// MaxProductPageSize caps the count/limit a ListProducts caller can
+	// request, regardless of what it asks for.
+	MaxProductPageSize int
+
+	// Idempotency-Key settings
+	IdempotencyStoreDriver string        // "redis" (default) or "memory"
+	RedisAddr              string        // host:port of the Redis instance backing the idempotency and saga stores
+	IdempotencyLeaseTTL    time.Duration // how long an in-flight claim is held before it is considered abandoned
+	IdempotencyWindow      time.Duration // how long a completed response is kept available for replay
+
+	// Order-placement saga settings
+	SagaStoreDriver    string        // "redis" (default) or "memory"
+	SagaReaperInterval time.Duration // how often the reaper polls for abandoned sagas
+
+	// Per-request deadlines applied by handlers.Base.StartRequest, bounding
+	// how long a handler's gRPC calls may run before the gateway gives up
+	// and returns to the caller.
+	HandlerReadTimeout  time.Duration // GET-style handlers: ListProducts, GetOrder, ...
+	HandlerWriteTimeout time.Duration // handlers that place or mutate an order/product
 }
 
 // Load reads configuration from environment variables
 func Load() *Config {
 	return &Config{
-		Port:                 getEnv("PORT", "8080"),
-		Environment:          getEnv("ENVIRONMENT", "development"),
-		JWTSecret:            getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
-		JWTExpiration:        getEnvAsInt("JWT_EXPIRATION_HOURS", 24),
-		UserServiceAddr:      getEnv("USER_SERVICE_ADDR", "localhost:50051"),
-		ListingServiceAddr:   getEnv("LISTING_SERVICE_ADDR", "localhost:50052"),
-		InventoryServiceAddr: getEnv("INVENTORY_SERVICE_ADDR", "localhost:50053"),
-		AllowedOrigins:       getEnvAsSlice("ALLOWED_ORIGINS", []string{"http://localhost:3000"}),
-		RateLimit:            getEnvAsInt("RATE_LIMIT", 100),
+		Port:                   getEnv("PORT", "8080"),
+		Environment:            getEnv("ENVIRONMENT", "development"),
+		JWTSecret:              getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
+		JWTExpiration:          getEnvAsInt("JWT_EXPIRATION_HOURS", 24),
+		JWTKeyMode:             getEnv("JWT_KEY_MODE", "hmac"),
+		JWKSURL:                getEnv("JWKS_URL", ""),
+		JWKSRefreshInterval:    time.Duration(getEnvAsInt("JWKS_REFRESH_SECONDS", 300)) * time.Second,
+		RSAPrivateKeyPath:      getEnv("JWT_RSA_PRIVATE_KEY_PATH", ""),
+		AdminRoles:             getEnvAsSlice("ADMIN_ROLES", []string{"admin"}),
+		UserServiceAddr:        getEnv("USER_SERVICE_ADDR", "localhost:50051"),
+		ListingServiceAddr:     getEnv("LISTING_SERVICE_ADDR", "localhost:50052"),
+		InventoryServiceAddr:   getEnv("INVENTORY_SERVICE_ADDR", "localhost:50053"),
+		GRPCMaxRetries:         getEnvAsInt("GRPC_MAX_RETRIES", 5),
+		GRPCInitialBackoff:     time.Duration(getEnvAsInt("GRPC_INITIAL_BACKOFF_MS", 500)) * time.Millisecond,
+		GRPCMaxBackoff:         time.Duration(getEnvAsInt("GRPC_MAX_BACKOFF_SECONDS", 30)) * time.Second,
+		GRPCKeepaliveInterval:  time.Duration(getEnvAsInt("GRPC_KEEPALIVE_SECONDS", 30)) * time.Second,
+		RegistryDriver:         getEnv("REGISTRY_DRIVER", "static"),
+		ConsulAddr:             getEnv("CONSUL_ADDR", ""),
+		EtcdEndpoints:          getEnvAsSlice("ETCD_ENDPOINTS", nil),
+		AllowedOrigins:         getEnvAsSlice("ALLOWED_ORIGINS", []string{"http://localhost:3000"}),
+		RateLimit:              getEnvAsInt("RATE_LIMIT", 100),
+		MaxProductPageSize:     getEnvAsInt("MAX_PRODUCT_PAGE_SIZE", 100),
+		IdempotencyStoreDriver: getEnv("IDEMPOTENCY_STORE_DRIVER", "redis"),
+		RedisAddr:              getEnv("REDIS_ADDR", "localhost:6379"),
+		IdempotencyLeaseTTL:    time.Duration(getEnvAsInt("IDEMPOTENCY_LEASE_SECONDS", 30)) * time.Second,
+		IdempotencyWindow:      time.Duration(getEnvAsInt("IDEMPOTENCY_WINDOW_HOURS", 24)) * time.Hour,
+		SagaStoreDriver:        getEnv("SAGA_STORE_DRIVER", "redis"),
+		SagaReaperInterval:     time.Duration(getEnvAsInt("SAGA_REAPER_INTERVAL_SECONDS", 15)) * time.Second,
+		HandlerReadTimeout:     time.Duration(getEnvAsInt("HANDLER_READ_TIMEOUT_SECONDS", 3)) * time.Second,
+		HandlerWriteTimeout:    time.Duration(getEnvAsInt("HANDLER_WRITE_TIMEOUT_SECONDS", 10)) * time.Second,
 	}
 }
 
@@ -1,32 +1,71 @@
 package handlers
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/ecommerce/be-api-gin/internal/apierr"
+	"github.com/ecommerce/be-api-gin/internal/config"
 	"github.com/ecommerce/be-api-gin/internal/models"
 	grpcclient "github.com/ecommerce/be-api-gin/pkg/grpc"
+	"github.com/ecommerce/be-api-gin/pkg/saga"
 )
 
+// createOrderSagaKind names the Definition registered below, so a Reaper in
+// a fresh process can resolve a persisted Record's steps after a crash.
+const createOrderSagaKind = "create-order"
+
 // OrderHandler handles order-related requests
 type OrderHandler struct {
+	Base
 	grpcClients *grpcclient.Clients
+	sagas       *saga.Orchestrator
+}
+
+// NewOrderHandler creates a new order handler backed by a process-local
+// saga store. Use NewOrderHandlerWithSagaStore in production so a crashed
+// gateway's in-flight orders can still be resumed or unwound.
+func NewOrderHandler(clients *grpcclient.Clients, cfg *config.Config) *OrderHandler {
+	return NewOrderHandlerWithSagaStore(clients, saga.NewMemoryStore(), cfg)
 }
 
-// NewOrderHandler creates a new order handler
-func NewOrderHandler(clients *grpcclient.Clients) *OrderHandler {
-	return &OrderHandler{
+// NewOrderHandlerWithSagaStore creates an order handler whose CreateOrder
+// saga persists its progress to store.
+func NewOrderHandlerWithSagaStore(clients *grpcclient.Clients, store saga.Store, cfg *config.Config) *OrderHandler {
+	h := &OrderHandler{
+		Base:        NewBase(cfg),
 		grpcClients: clients,
+		sagas:       saga.NewOrchestrator(store),
 	}
+	h.sagas.Register(h.createOrderSagaDefinition())
+	return h
+}
+
+// Sagas exposes the orchestrator so a Reaper can be wired up against the
+// same Store at startup.
+func (h *OrderHandler) Sagas() *saga.Orchestrator {
+	return h.sagas
 }
 
 // ListOrders returns a list of orders for the authenticated user
 // GET /api/v1/orders
 func (h *OrderHandler) ListOrders(c *gin.Context) {
-	// Get user ID from context (set by auth middleware)
-	userID, _ := c.Get("userID")
+	ctx, user, errResp := h.StartRequest(c, nil)
+	if errResp != nil {
+		RespondError(c, errResp)
+		return
+	}
+	defer ctx.Cancel()
 
 	// Parse query parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
@@ -34,12 +73,9 @@ func (h *OrderHandler) ListOrders(c *gin.Context) {
 	status := c.Query("status")
 
 	// Call user service via gRPC to get orders
-	orders, total, err := h.grpcClients.ListOrders(c.Request.Context(), userID.(string), page, limit, status)
+	orders, total, err := h.grpcClients.ListOrders(ctx, user.ID, page, limit, status)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error:   "Failed to fetch orders",
-			Message: err.Error(),
-		})
+		RespondError(c, err)
 		return
 	}
 
@@ -56,139 +92,203 @@ func (h *OrderHandler) ListOrders(c *gin.Context) {
 // GET /api/v1/orders/:id
 func (h *OrderHandler) GetOrder(c *gin.Context) {
 	id := c.Param("id")
-	userID, _ := c.Get("userID")
+
+	ctx, user, errResp := h.StartRequest(c, nil)
+	if errResp != nil {
+		RespondError(c, errResp)
+		return
+	}
+	defer ctx.Cancel()
 
 	// Call user service via gRPC
-	order, err := h.grpcClients.GetOrder(c.Request.Context(), id, userID.(string))
+	order, err := h.grpcClients.GetOrder(ctx, id, user.ID)
 	if err != nil {
-		if err == grpcclient.ErrNotFound {
-			c.JSON(http.StatusNotFound, models.ErrorResponse{
-				Error:   "Order not found",
-				Message: "No order exists with the given ID",
-			})
-			return
-		}
-		if err == grpcclient.ErrUnauthorized {
-			c.JSON(http.StatusForbidden, models.ErrorResponse{
-				Error:   "Unauthorized",
-				Message: "You don't have permission to view this order",
-			})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error:   "Failed to fetch order",
-			Message: err.Error(),
-		})
+		RespondError(c, specialize(err, apierr.CodeOrderNotFound))
 		return
 	}
 
 	c.JSON(http.StatusOK, order)
 }
 
-// CreateOrder creates a new order
+// CreateOrder places an order as a durable saga: CheckInventory ->
+// ReserveInventory -> CreateOrder -> ConfirmReservation. Progress is
+// persisted after every step, so unlike the hand-rolled rollback loop this
+// replaces, a gateway crash mid-checkout leaves a Record a Reaper can
+// resume or compensate instead of a reservation nobody will ever release.
 // POST /api/v1/orders
 func (h *OrderHandler) CreateOrder(c *gin.Context) {
 	var req models.CreateOrderRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error:   "Invalid request body",
-			Message: err.Error(),
-		})
+	ctx, user, errResp := h.StartWriteRequest(c, &req)
+	if errResp != nil {
+		RespondError(c, errResp)
 		return
 	}
+	defer ctx.Cancel()
 
-	userID, _ := c.Get("userID")
+	encodedReq, err := json.Marshal(req)
+	if err != nil {
+		RespondError(c, apierr.New(apierr.CodeInternal, err))
+		return
+	}
 
-	// Validate inventory availability for all items
-	for _, item := range req.Items {
-		available, err := h.grpcClients.CheckInventory(c.Request.Context(), item.ProductID, item.Quantity)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-				Error:   "Failed to check inventory",
-				Message: err.Error(),
-			})
-			return
-		}
-		if !available {
-			c.JSON(http.StatusBadRequest, models.ErrorResponse{
-				Error:   "Insufficient inventory",
-				Message: "Product " + item.ProductID + " does not have enough stock",
-			})
-			return
-		}
+	sagaID := newSagaID()
+	rec, err := h.sagas.Start(ctx, createOrderSagaKind, sagaID, user.ID, map[string]string{
+		"request": string(encodedReq),
+	})
+	if err != nil {
+		RespondError(c, err)
+		return
 	}
 
-	// Reserve inventory for all items
-	reservationIDs := make([]string, 0, len(req.Items))
-	for _, item := range req.Items {
-		reservationID, err := h.grpcClients.ReserveInventory(c.Request.Context(), item.ProductID, item.Quantity)
-		if err != nil {
-			// Rollback previous reservations
-			for _, rid := range reservationIDs {
-				h.grpcClients.CancelReservation(c.Request.Context(), rid)
-			}
-			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-				Error:   "Failed to reserve inventory",
-				Message: err.Error(),
-			})
-			return
-		}
-		reservationIDs = append(reservationIDs, reservationID)
+	var order models.Order
+	if err := json.Unmarshal([]byte(rec.Data["order"]), &order); err != nil {
+		RespondError(c, apierr.New(apierr.CodeInternal, err).WithMessage("Order placed but its confirmation could not be read back"))
+		return
 	}
 
-	// Create the order
-	order, err := h.grpcClients.CreateOrder(c.Request.Context(), userID.(string), &req, reservationIDs)
+	c.Header("X-Saga-ID", sagaID)
+	c.JSON(http.StatusCreated, order)
+}
+
+// GetOrderSaga returns the durable saga Record behind a CreateOrder call
+// (its ID is the X-Saga-ID header returned from that call), for diagnosing
+// an order stuck mid-checkout or a checkout that was compensated away.
+// GET /api/v1/orders/:id/saga
+func (h *OrderHandler) GetOrderSaga(c *gin.Context) {
+	id := c.Param("id")
+
+	ctx, _, errResp := h.StartRequest(c, nil)
+	if errResp != nil {
+		RespondError(c, errResp)
+		return
+	}
+	defer ctx.Cancel()
+
+	rec, err := h.sagas.Get(id)
 	if err != nil {
-		// Rollback reservations on failure
-		for _, rid := range reservationIDs {
-			h.grpcClients.CancelReservation(c.Request.Context(), rid)
+		if err == saga.ErrNotFound {
+			RespondError(c, apierr.New(apierr.CodeNotFound, err).WithMessage("No saga exists with the given ID"))
+			return
 		}
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error:   "Failed to create order",
-			Message: err.Error(),
-		})
+		RespondError(c, apierr.New(apierr.CodeInternal, err))
 		return
 	}
 
-	c.JSON(http.StatusCreated, order)
+	c.JSON(http.StatusOK, rec)
+}
+
+// createOrderSagaDefinition is registered once, at handler construction, so
+// a Reaper resuming a persisted Record after a crash can resolve these same
+// steps by Kind alone.
+func (h *OrderHandler) createOrderSagaDefinition() saga.Definition {
+	return saga.Definition{
+		Kind: createOrderSagaKind,
+		Steps: []saga.Step{
+			{
+				Name:    "check-inventory",
+				Timeout: 5 * time.Second,
+				Do: func(ctx context.Context, run *saga.Run) error {
+					req, err := decodeOrderRequest(run)
+					if err != nil {
+						return err
+					}
+					for _, item := range req.Items {
+						available, err := h.grpcClients.CheckInventory(ctx, item.ProductID, item.Quantity)
+						if err != nil {
+							return err
+						}
+						if !available {
+							return apierr.New(apierr.CodeInventoryInsufficient, nil).
+								WithMessage(fmt.Sprintf("Product %s does not have enough stock", item.ProductID))
+						}
+					}
+					return nil
+				},
+			},
+			{
+				Name:    "reserve-inventory",
+				Timeout: 10 * time.Second,
+				Do: func(ctx context.Context, run *saga.Run) error {
+					req, err := decodeOrderRequest(run)
+					if err != nil {
+						return err
+					}
+					reservationIDs := splitNonEmpty(run.Get("reservation_ids"))
+					for _, item := range req.Items[len(reservationIDs):] {
+						reservationID, err := h.grpcClients.ReserveInventory(ctx, item.ProductID, item.Quantity)
+						if err != nil {
+							return err
+						}
+						reservationIDs = append(reservationIDs, reservationID)
+						run.Set("reservation_ids", strings.Join(reservationIDs, ","))
+					}
+					return nil
+				},
+				Compensate: func(ctx context.Context, run *saga.Run) error {
+					var firstErr error
+					for _, reservationID := range splitNonEmpty(run.Get("reservation_ids")) {
+						if err := h.grpcClients.CancelReservation(ctx, reservationID); err != nil && firstErr == nil {
+							firstErr = err
+						}
+					}
+					return firstErr
+				},
+			},
+			{
+				Name:    "create-order",
+				Timeout: 10 * time.Second,
+				Do: func(ctx context.Context, run *saga.Run) error {
+					req, err := decodeOrderRequest(run)
+					if err != nil {
+						return err
+					}
+					order, err := h.grpcClients.CreateOrder(ctx, run.UserID, req, splitNonEmpty(run.Get("reservation_ids")))
+					if err != nil {
+						return err
+					}
+					run.Set("order_id", order.ID)
+					return encodeOrder(run, order)
+				},
+				Compensate: func(ctx context.Context, run *saga.Run) error {
+					orderID := run.Get("order_id")
+					if orderID == "" {
+						return nil
+					}
+					return h.grpcClients.CancelOrder(ctx, orderID, run.UserID)
+				},
+			},
+			{
+				Name:    "confirm-reservation",
+				Timeout: 5 * time.Second,
+				Do: func(ctx context.Context, run *saga.Run) error {
+					order, err := h.grpcClients.UpdateOrderStatus(ctx, run.Get("order_id"), run.UserID, "confirmed")
+					if err != nil {
+						return err
+					}
+					return encodeOrder(run, order)
+				},
+			},
+		},
+	}
 }
 
 // UpdateOrderStatus updates the status of an order
 // PUT /api/v1/orders/:id/status
 func (h *OrderHandler) UpdateOrderStatus(c *gin.Context) {
 	id := c.Param("id")
-	userID, _ := c.Get("userID")
 
 	var req models.UpdateOrderStatusRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error:   "Invalid request body",
-			Message: err.Error(),
-		})
+	ctx, user, errResp := h.StartWriteRequest(c, &req)
+	if errResp != nil {
+		RespondError(c, errResp)
 		return
 	}
+	defer ctx.Cancel()
 
 	// Call user service via gRPC
-	order, err := h.grpcClients.UpdateOrderStatus(c.Request.Context(), id, userID.(string), req.Status)
+	order, err := h.grpcClients.UpdateOrderStatus(ctx, id, user.ID, req.Status)
 	if err != nil {
-		if err == grpcclient.ErrNotFound {
-			c.JSON(http.StatusNotFound, models.ErrorResponse{
-				Error:   "Order not found",
-				Message: "No order exists with the given ID",
-			})
-			return
-		}
-		if err == grpcclient.ErrUnauthorized {
-			c.JSON(http.StatusForbidden, models.ErrorResponse{
-				Error:   "Unauthorized",
-				Message: "You don't have permission to update this order",
-			})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error:   "Failed to update order status",
-			Message: err.Error(),
-		})
+		RespondError(c, specialize(err, apierr.CodeOrderNotFound))
 		return
 	}
 
@@ -199,57 +299,74 @@ func (h *OrderHandler) UpdateOrderStatus(c *gin.Context) {
 // DELETE /api/v1/orders/:id
 func (h *OrderHandler) CancelOrder(c *gin.Context) {
 	id := c.Param("id")
-	userID, _ := c.Get("userID")
+
+	ctx, user, errResp := h.StartWriteRequest(c, nil)
+	if errResp != nil {
+		RespondError(c, errResp)
+		return
+	}
+	defer ctx.Cancel()
 
 	// Get the order first to retrieve reservation IDs
-	order, err := h.grpcClients.GetOrder(c.Request.Context(), id, userID.(string))
+	order, err := h.grpcClients.GetOrder(ctx, id, user.ID)
 	if err != nil {
-		if err == grpcclient.ErrNotFound {
-			c.JSON(http.StatusNotFound, models.ErrorResponse{
-				Error:   "Order not found",
-				Message: "No order exists with the given ID",
-			})
-			return
-		}
-		if err == grpcclient.ErrUnauthorized {
-			c.JSON(http.StatusForbidden, models.ErrorResponse{
-				Error:   "Unauthorized",
-				Message: "You don't have permission to cancel this order",
-			})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error:   "Failed to fetch order",
-			Message: err.Error(),
-		})
+		RespondError(c, specialize(err, apierr.CodeOrderNotFound))
 		return
 	}
 
 	// Check if order can be cancelled
 	if order.Status != "pending" && order.Status != "confirmed" {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error:   "Cannot cancel order",
-			Message: "Order can only be cancelled when in pending or confirmed status",
-		})
+		RespondError(c, apierr.New(apierr.CodeOrderNotCancellable, nil))
 		return
 	}
 
 	// Cancel the order
-	err = h.grpcClients.CancelOrder(c.Request.Context(), id, userID.(string))
+	err = h.grpcClients.CancelOrder(ctx, id, user.ID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error:   "Failed to cancel order",
-			Message: err.Error(),
-		})
+		RespondError(c, err)
 		return
 	}
 
 	// Release inventory reservations
 	for _, reservationID := range order.ReservationIDs {
-		h.grpcClients.CancelReservation(c.Request.Context(), reservationID)
+		if err := h.grpcClients.CancelReservation(ctx, reservationID); err != nil {
+			log.Printf("cancel order %s: failed to release reservation %s: %v", id, reservationID, err)
+		}
 	}
 
 	c.JSON(http.StatusOK, models.SuccessResponse{
 		Message: "Order cancelled successfully",
 	})
 }
+
+func decodeOrderRequest(run *saga.Run) (*models.CreateOrderRequest, error) {
+	var req models.CreateOrderRequest
+	if err := json.Unmarshal([]byte(run.Get("request")), &req); err != nil {
+		return nil, fmt.Errorf("saga %s: decoding stored request: %w", run.ID, err)
+	}
+	return &req, nil
+}
+
+func encodeOrder(run *saga.Run, order *models.Order) error {
+	encoded, err := json.Marshal(order)
+	if err != nil {
+		return fmt.Errorf("saga %s: encoding order: %w", run.ID, err)
+	}
+	run.Set("order", string(encoded))
+	return nil
+}
+
+func splitNonEmpty(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// newSagaID generates a random saga identifier for correlating saga steps in
+// logs and the saga store.
+func newSagaID() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return "saga-" + hex.EncodeToString(b[:])
+}